@@ -0,0 +1,324 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"sort"
+)
+
+const (
+	defaultCriticalLimit = 10
+	maxCriticalLimit     = 100
+)
+
+// structure holds the catalog in the shape the analyses need, with keys and
+// neighbours in a stable order.
+type structure struct {
+	keys     []string
+	vertices map[string]Vertex
+	edges    map[string]Edge
+	next     map[string][]string
+	prev     map[string][]string
+	// both ignores the direction of the dependencies
+	both map[string][]string
+}
+
+func (api *API) structure() structure {
+	return newStructure(api.graphSnapshot())
+}
+
+func newStructure(vertices map[string]Vertex, edges map[string]Edge) structure {
+	s := structure{
+		keys:     make([]string, 0, len(vertices)),
+		vertices: vertices,
+		edges:    edges,
+	}
+	for k := range vertices {
+		s.keys = append(s.keys, k)
+	}
+	sort.Strings(s.keys)
+
+	all := s.edgeList()
+	s.next = adjacency(all, false)
+	s.prev = adjacency(all, true)
+	s.both = map[string][]string{}
+	for _, k := range s.keys {
+		s.both[k] = append(append([]string{}, s.next[k]...), s.prev[k]...)
+		sort.Strings(s.both[k])
+	}
+	return s
+}
+
+func (s structure) edgeList() []Edge {
+	keys := make([]string, 0, len(s.edges))
+	for k := range s.edges {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	edges := make([]Edge, 0, len(keys))
+	for _, k := range keys {
+		edges = append(edges, s.edges[k])
+	}
+	return edges
+}
+
+// edge returns the edge between a and b in whichever direction it exists.
+func (s structure) edge(a, b string) Edge {
+	if e, ok := s.edges[edgeKey(a, b)]; ok {
+		return e
+	}
+	return s.edges[edgeKey(b, a)]
+}
+
+// components returns the strongly connected components with Tarjan's
+// algorithm.
+func (s structure) components() [][]string {
+	index := map[string]int{}
+	low := map[string]int{}
+	onStack := map[string]bool{}
+	stack := []string{}
+	found := [][]string{}
+
+	var connect func(v string)
+	connect = func(v string) {
+		index[v] = len(index)
+		low[v] = index[v]
+		stack = append(stack, v)
+		onStack[v] = true
+
+		for _, w := range s.next[v] {
+			if _, seen := index[w]; !seen {
+				connect(w)
+				low[v] = min(low[v], low[w])
+			} else if onStack[w] {
+				low[v] = min(low[v], index[w])
+			}
+		}
+
+		if low[v] == index[v] {
+			component := []string{}
+			for {
+				w := stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+				onStack[w] = false
+				component = append(component, w)
+				if w == v {
+					break
+				}
+			}
+			sort.Strings(component)
+			found = append(found, component)
+		}
+	}
+
+	for _, k := range s.keys {
+		if _, seen := index[k]; !seen {
+			connect(k)
+		}
+	}
+	return found
+}
+
+// cuts returns, ignoring direction, the articulation points with the number
+// of parts left without each of them, and the bridges.
+func (s structure) cuts() (map[string]int, []Edge) {
+	disc := map[string]int{}
+	low := map[string]int{}
+	points := map[string]int{}
+	bridges := []Edge{}
+
+	var visit func(v, parent string)
+	visit = func(v, parent string) {
+		disc[v] = len(disc)
+		low[v] = disc[v]
+		children, splits := 0, 0
+
+		for _, w := range s.both[v] {
+			if w == parent {
+				continue
+			}
+			if _, seen := disc[w]; seen {
+				low[v] = min(low[v], disc[w])
+				continue
+			}
+			children++
+			visit(w, v)
+			low[v] = min(low[v], low[w])
+			if low[w] >= disc[v] {
+				splits++
+			}
+			if low[w] > disc[v] {
+				bridges = append(bridges, s.edge(v, w))
+			}
+		}
+
+		if parent == "" && children > 1 {
+			points[v] = children
+		}
+		if parent != "" && splits > 0 {
+			points[v] = splits + 1
+		}
+	}
+
+	for _, k := range s.keys {
+		if _, seen := disc[k]; !seen {
+			visit(k, "")
+		}
+	}
+	return points, bridges
+}
+
+// betweenness returns the betweenness centrality of every vertex along the
+// dependencies, with Brandes' algorithm.
+func (s structure) betweenness() map[string]float64 {
+	score := make(map[string]float64, len(s.keys))
+	for _, src := range s.keys {
+		order := []string{}
+		preds := map[string][]string{}
+		paths := map[string]float64{src: 1}
+		dist := map[string]int{src: 0}
+
+		queue := []string{src}
+		for len(queue) > 0 {
+			v := queue[0]
+			queue = queue[1:]
+			order = append(order, v)
+			for _, w := range s.next[v] {
+				if _, seen := dist[w]; !seen {
+					dist[w] = dist[v] + 1
+					queue = append(queue, w)
+				}
+				if dist[w] == dist[v]+1 {
+					paths[w] += paths[v]
+					preds[w] = append(preds[w], v)
+				}
+			}
+		}
+
+		delta := map[string]float64{}
+		for i := len(order) - 1; i >= 0; i-- {
+			w := order[i]
+			for _, v := range preds[w] {
+				delta[v] += paths[v] / paths[w] * (1 + delta[w])
+			}
+			if w != src {
+				score[w] += delta[w]
+			}
+		}
+	}
+	return score
+}
+
+// cycles returns the strongly connected components with more than one
+// vertex, with the edges between their members.
+func (s structure) cycles() []Cycle {
+	cycles := []Cycle{}
+	for _, component := range s.components() {
+		if len(component) < 2 {
+			continue
+		}
+		members := make(map[string]struct{}, len(component))
+		cycle := Cycle{Vertices: []Vertex{}, Edges: []Edge{}}
+		for _, k := range component {
+			members[k] = struct{}{}
+			cycle.Vertices = append(cycle.Vertices, s.vertices[k])
+		}
+		for _, e := range s.edgeList() {
+			_, src := members[e.Source]
+			_, tgt := members[e.Target]
+			if src && tgt {
+				cycle.Edges = append(cycle.Edges, e)
+			}
+		}
+		cycles = append(cycles, cycle)
+	}
+	sort.Slice(cycles, func(i, j int) bool { return cycles[i].Vertices[0].Key < cycles[j].Vertices[0].Key })
+	return cycles
+}
+
+// GetCycles always finds none today, as graphlib refuses the edges that
+// would close a cycle.
+func (api *API) GetCycles(ctx context.Context, request GetCyclesRequestObject) (GetCyclesResponseObject, error) {
+	cycles := api.structure().cycles()
+	return GetCycles200JSONResponse(Cycles{Count: len(cycles), Cycles: cycles}), nil
+}
+
+func (api *API) GetSinglePointsOfFailure(ctx context.Context, request GetSinglePointsOfFailureRequestObject) (GetSinglePointsOfFailureResponseObject, error) {
+	s := api.structure()
+	points, bridges := s.cuts()
+
+	res := SinglePointsOfFailure{
+		ArticulationPoints: []ArticulationPoint{},
+		Bridges:            bridges,
+	}
+	for _, k := range s.keys {
+		if n, ok := points[k]; ok {
+			res.ArticulationPoints = append(res.ArticulationPoints, ArticulationPoint{Vertex: s.vertices[k], Components: n})
+		}
+	}
+	sort.Slice(res.Bridges, func(i, j int) bool { return res.Bridges[i].Key < res.Bridges[j].Key })
+
+	return GetSinglePointsOfFailure200JSONResponse(res), nil
+}
+
+func (api *API) GetCriticalVertices(ctx context.Context, request GetCriticalVerticesRequestObject) (GetCriticalVerticesResponseObject, error) {
+	metric := GetCriticalVerticesParamsMetricInDegree
+	if request.Params.Metric != nil {
+		metric = *request.Params.Metric
+	}
+	if metric != GetCriticalVerticesParamsMetricInDegree && metric != GetCriticalVerticesParamsMetricBetweenness {
+		ir := InvalidRequestJSONResponse{Code: 422, Error: fmt.Sprintf("unknown metric %q", metric)}
+		return GetCriticalVertices422JSONResponse{InvalidRequestJSONResponse: ir}, nil
+	}
+	limit := defaultCriticalLimit
+	if request.Params.Limit != nil {
+		limit = *request.Params.Limit
+	}
+	if limit < 1 || limit > maxCriticalLimit {
+		ir := InvalidRequestJSONResponse{Code: 422, Error: fmt.Sprintf("limit must be between 1 and %d", maxCriticalLimit)}
+		return GetCriticalVertices422JSONResponse{InvalidRequestJSONResponse: ir}, nil
+	}
+
+	s := api.structure()
+	between := s.betweenness()
+
+	items := make([]CriticalVertex, 0, len(s.keys))
+	for _, k := range s.keys {
+		items = append(items, CriticalVertex{
+			Vertex:      s.vertices[k],
+			InDegree:    len(s.prev[k]),
+			OutDegree:   len(s.next[k]),
+			Betweenness: between[k],
+		})
+	}
+	sort.SliceStable(items, func(i, j int) bool {
+		if metric == GetCriticalVerticesParamsMetricBetweenness {
+			return items[i].Betweenness > items[j].Betweenness
+		}
+		return items[i].InDegree > items[j].InDegree
+	})
+	if len(items) > limit {
+		items = items[:limit]
+	}
+	for i := range items {
+		items[i].Rank = i + 1
+	}
+
+	res := CriticalVertices{Metric: CriticalVerticesMetric(metric), Items: items}
+	return GetCriticalVertices200JSONResponse(res), nil
+}
+
+func (api *API) GetOrphans(ctx context.Context, request GetOrphansRequestObject) (GetOrphansResponseObject, error) {
+	s := api.structure()
+
+	res := Orphans{Items: []Vertex{}}
+	for _, k := range s.keys {
+		if len(s.both[k]) == 0 {
+			res.Items = append(res.Items, s.vertices[k])
+		}
+	}
+	res.Total = len(res.Items)
+
+	return GetOrphans200JSONResponse(res), nil
+}
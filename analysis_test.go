@@ -0,0 +1,185 @@
+package api
+
+import (
+	"math"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+// testStructure builds a structure from "src>tgt" pairs and lone vertices.
+func testStructure(lone []string, pairs ...string) structure {
+	vertices := map[string]Vertex{}
+	edges := map[string]Edge{}
+	for _, k := range lone {
+		vertices[k] = Vertex{Key: k}
+	}
+	for _, e := range testEdges(pairs...) {
+		vertices[e.Source] = Vertex{Key: e.Source}
+		vertices[e.Target] = Vertex{Key: e.Target}
+		edges[e.Key] = e
+	}
+	return newStructure(vertices, edges)
+}
+
+func TestComponents(t *testing.T) {
+	cases := []struct {
+		name  string
+		lone  []string
+		pairs []string
+		want  [][]string
+	}{
+		{name: "chain", pairs: []string{"a>b", "b>c"}, want: [][]string{{"a"}, {"b"}, {"c"}}},
+		{name: "cycle and tail", pairs: []string{"a>b", "b>c", "c>a", "c>d"}, want: [][]string{{"a", "b", "c"}, {"d"}}},
+		{name: "two cycles", pairs: []string{"a>b", "b>a", "b>c", "c>d", "d>c"}, want: [][]string{{"a", "b"}, {"c", "d"}}},
+		{name: "lone vertex", lone: []string{"z"}, pairs: []string{"a>b"}, want: [][]string{{"a"}, {"b"}, {"z"}}},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := testStructure(c.lone, c.pairs...).components()
+			sort.Slice(got, func(i, j int) bool { return got[i][0] < got[j][0] })
+			if !reflect.DeepEqual(got, c.want) {
+				t.Errorf("got %v, want %v", got, c.want)
+			}
+		})
+	}
+}
+
+func TestCycles(t *testing.T) {
+	s := testStructure(nil, "a>b", "b>c", "c>a", "c>d", "d>e", "e>d")
+	cycles := s.cycles()
+	if len(cycles) != 2 {
+		t.Fatalf("got %d cycles, want 2", len(cycles))
+	}
+
+	keys := func(c Cycle) ([]string, []string) {
+		vs, es := []string{}, []string{}
+		for _, v := range c.Vertices {
+			vs = append(vs, v.Key)
+		}
+		for _, e := range c.Edges {
+			es = append(es, e.Key)
+		}
+		return vs, es
+	}
+	vs, es := keys(cycles[0])
+	if !reflect.DeepEqual(vs, []string{"a", "b", "c"}) || !reflect.DeepEqual(es, []string{"a->b", "b->c", "c->a"}) {
+		t.Errorf("first cycle has %v and %v", vs, es)
+	}
+	vs, es = keys(cycles[1])
+	if !reflect.DeepEqual(vs, []string{"d", "e"}) || !reflect.DeepEqual(es, []string{"d->e", "e->d"}) {
+		t.Errorf("second cycle has %v and %v", vs, es)
+	}
+
+	if n := len(testStructure(nil, "a>b", "b>c", "a>c").cycles()); n != 0 {
+		t.Errorf("acyclic graph has %d cycles", n)
+	}
+}
+
+func TestCuts(t *testing.T) {
+	cases := []struct {
+		name    string
+		lone    []string
+		pairs   []string
+		points  map[string]int
+		bridges []string
+	}{
+		{
+			name:    "chain",
+			pairs:   []string{"a>b", "b>c"},
+			points:  map[string]int{"b": 2},
+			bridges: []string{"a->b", "b->c"},
+		},
+		{
+			name:    "star",
+			pairs:   []string{"a>hub", "b>hub", "c>hub"},
+			points:  map[string]int{"hub": 3},
+			bridges: []string{"a->hub", "b->hub", "c->hub"},
+		},
+		{
+			name:    "triangle with a tail",
+			pairs:   []string{"a>b", "b>c", "a>c", "c>d"},
+			points:  map[string]int{"c": 2},
+			bridges: []string{"c->d"},
+		},
+		{
+			name:    "redundant pair",
+			pairs:   []string{"lb>w1", "lb>w2", "w1>db", "w2>db"},
+			points:  map[string]int{},
+			bridges: []string{},
+		},
+		{
+			name:    "direction is ignored",
+			pairs:   []string{"a>b", "c>b", "c>d"},
+			points:  map[string]int{"b": 2, "c": 2},
+			bridges: []string{"a->b", "c->b", "c->d"},
+		},
+		{
+			name:    "separate parts",
+			lone:    []string{"z"},
+			pairs:   []string{"a>b", "b>c", "x>y"},
+			points:  map[string]int{"b": 2},
+			bridges: []string{"a->b", "b->c", "x->y"},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			points, bridges := testStructure(c.lone, c.pairs...).cuts()
+			if !reflect.DeepEqual(points, c.points) {
+				t.Errorf("got articulation points %v, want %v", points, c.points)
+			}
+			keys := []string{}
+			for _, e := range bridges {
+				keys = append(keys, e.Key)
+			}
+			sort.Strings(keys)
+			if !reflect.DeepEqual(keys, c.bridges) {
+				t.Errorf("got bridges %v, want %v", keys, c.bridges)
+			}
+		})
+	}
+}
+
+func TestBetweenness(t *testing.T) {
+	cases := []struct {
+		name  string
+		pairs []string
+		want  map[string]float64
+	}{
+		{
+			name:  "chain",
+			pairs: []string{"a>b", "b>c"},
+			want:  map[string]float64{"b": 1},
+		},
+		{
+			name:  "diamond splits the paths",
+			pairs: []string{"a>b", "a>c", "b>d", "c>d"},
+			want:  map[string]float64{"b": 0.5, "c": 0.5},
+		},
+		{
+			name:  "longer chain",
+			pairs: []string{"a>b", "b>c", "c>d"},
+			want:  map[string]float64{"b": 2, "c": 2},
+		},
+		{
+			name:  "shortcut",
+			pairs: []string{"a>b", "b>c", "a>c"},
+			want:  map[string]float64{},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := testStructure(nil, c.pairs...).betweenness()
+			for k, v := range got {
+				if math.Abs(v-c.want[k]) > 1e-9 {
+					t.Errorf("%s: got %v, want %v", k, v, c.want[k])
+				}
+			}
+			for k, v := range c.want {
+				if _, ok := got[k]; !ok {
+					t.Errorf("%s: missing, want %v", k, v)
+				}
+			}
+		})
+	}
+}
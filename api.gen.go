@@ -12,26 +12,183 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"io"
+	"mime/multipart"
 	"net/http"
 	"net/url"
 	"path"
 	"strings"
+	"time"
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/oapi-codegen/runtime"
 	strictnethttp "github.com/oapi-codegen/runtime/strictmiddleware/nethttp"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
 const (
 	BearerHttpAuthenticationScopes = "bearerHttpAuthentication.Scopes"
 )
 
+// Defines values for CriticalVerticesMetric.
+const (
+	CriticalVerticesMetricBetweenness CriticalVerticesMetric = "betweenness"
+	CriticalVerticesMetricInDegree    CriticalVerticesMetric = "in_degree"
+)
+
+// Defines values for HealthOrigin.
+const (
+	Direct    HealthOrigin = "direct"
+	Inherited HealthOrigin = "inherited"
+)
+
+// Defines values for HealthStatus.
+const (
+	Degraded HealthStatus = "degraded"
+	Down     HealthStatus = "down"
+	Healthy  HealthStatus = "healthy"
+	Unknown  HealthStatus = "unknown"
+)
+
+// Defines values for PropagationMode.
+const (
+	All    PropagationMode = "all"
+	Any    PropagationMode = "any"
+	Ignore PropagationMode = "ignore"
+	KOfN   PropagationMode = "k_of_n"
+)
+
+// Defines values for ResolvedPropagationSource.
+const (
+	ResolvedPropagationSourceClass   ResolvedPropagationSource = "class"
+	ResolvedPropagationSourceDefault ResolvedPropagationSource = "default"
+	ResolvedPropagationSourceVertex  ResolvedPropagationSource = "vertex"
+)
+
+// Defines values for Severity.
+const (
+	Critical Severity = "critical"
+	Major    Severity = "major"
+	Minor    Severity = "minor"
+	Warning  Severity = "warning"
+)
+
+// Defines values for GetCriticalVerticesParamsMetric.
+const (
+	GetCriticalVerticesParamsMetricBetweenness GetCriticalVerticesParamsMetric = "betweenness"
+	GetCriticalVerticesParamsMetricInDegree    GetCriticalVerticesParamsMetric = "in_degree"
+)
+
+// Defines values for ImportGraphParamsMode.
+const (
+	Replace ImportGraphParamsMode = "replace"
+	Upsert  ImportGraphParamsMode = "upsert"
+)
+
+// ArticulationPoint defines model for ArticulationPoint.
+type ArticulationPoint struct {
+	// Components Quantidade de partes desconectadas que sobram sem o recurso
+	Components int `json:"components"`
+
+	// Vertex Um ativo de TI
+	Vertex Vertex `json:"vertex"`
+}
+
+// ClassPropagation defines model for ClassPropagation.
+type ClassPropagation struct {
+	// Class Classe dos recursos
+	Class  string            `json:"class"`
+	Policy PropagationPolicy `json:"policy"`
+}
+
+// ClassSummary defines model for ClassSummary.
+type ClassSummary struct {
+	// Class Classe dos recursos
+	Class string `json:"class"`
+
+	// HealthyRatio Fração de recursos saudáveis da classe, de 0 a 1
+	HealthyRatio float64      `json:"healthy_ratio"`
+	Statuses     StatusCounts `json:"statuses"`
+
+	// Total Quantidade de recursos da classe
+	Total int `json:"total"`
+
+	// Unhealthy Quantidade de recursos não saudáveis da classe
+	Unhealthy int `json:"unhealthy"`
+}
+
+// CriticalVertex defines model for CriticalVertex.
+type CriticalVertex struct {
+	// Betweenness Quantidade de caminhos mais curtos entre outros recursos que passam por este
+	Betweenness float64 `json:"betweenness"`
+
+	// InDegree Quantidade de recursos que dependem diretamente deste
+	InDegree int `json:"in_degree"`
+
+	// OutDegree Quantidade de dependências diretas do recurso
+	OutDegree int `json:"out_degree"`
+
+	// Rank Posição na classificação, começando em 1
+	Rank int `json:"rank"`
+
+	// Vertex Um ativo de TI
+	Vertex Vertex `json:"vertex"`
+}
+
+// CriticalVertices defines model for CriticalVertices.
+type CriticalVertices struct {
+	// Items Recursos do mais ao menos crítico
+	Items []CriticalVertex `json:"items"`
+
+	// Metric Métrica usada na classificação
+	Metric CriticalVerticesMetric `json:"metric"`
+}
+
+// CriticalVerticesMetric Métrica usada na classificação
+type CriticalVerticesMetric string
+
+// Cycle defines model for Cycle.
+type Cycle struct {
+	// Edges Dependências entre os recursos do ciclo
+	Edges []Edge `json:"edges"`
+
+	// Vertices Recursos do componente fortemente conexo
+	Vertices []Vertex `json:"vertices"`
+}
+
+// Cycles defines model for Cycles.
+type Cycles struct {
+	// Count Quantidade de ciclos
+	Count int `json:"count"`
+
+	// Cycles Componentes fortemente conexos com mais de um recurso
+	Cycles []Cycle `json:"cycles"`
+}
+
+// CytoscapeElement Um nó ou aresta no formato de elementos do Cytoscape.js
+type CytoscapeElement struct {
+	// Data Dados do elemento. Nós têm id, label, class e healthy; arestas têm id, source, target, label e class.
+	Data map[string]interface{} `json:"data"`
+}
+
+// CytoscapeElements Elementos do grafo
+type CytoscapeElements struct {
+	Edges []CytoscapeElement `json:"edges"`
+	Nodes []CytoscapeElement `json:"nodes"`
+}
+
+// CytoscapeGraph Grafo no formato JSON do Cytoscape.js
+type CytoscapeGraph struct {
+	// Elements Elementos do grafo
+	Elements CytoscapeElements `json:"elements"`
+}
+
 // Edge Um relacionamento entre recursos
 type Edge struct {
 	// Class Classe ou Categoria do relacionamento
 	Class string `json:"class"`
 
-	// Key Identificador único do relacionamento
+	// Key Identificador único do relacionamento, no formato origem->destino. Um > ou \ na chave de um recurso aparece escapado com uma barra invertida.
 	Key string `json:"key"`
 
 	// Label Nome do relacionamento que será exibido
@@ -44,719 +201,767 @@ type Edge struct {
 	Target string `json:"target"`
 }
 
-// Subgraph Recursos e seus relacionamentos apresentados de forma útil para consumo e visualização.
-type Subgraph struct {
-	// All Se verdadeiro, retorna todos os itens do grafo, mesmo que não estejam conectados diretamente.
-	All bool `json:"all"`
+// EdgeUpdate Dados alteráveis de um relacionamento
+type EdgeUpdate struct {
+	// Class Classe ou Categoria do relacionamento
+	Class string `json:"class"`
 
-	// Edges Lista de relacionamentos que devem ser exibidos no grafo
-	Edges []Edge `json:"edges"`
+	// Label Nome do relacionamento que será exibido
+	Label string `json:"label"`
+}
 
-	// Highlights Elementos que devem ser destacados no grafo
-	Highlights []Vertex `json:"highlights"`
+// Event Uma mudança de saúde ou do grafo. É enviado como o campo data de um evento SSE cujo id e event repetem os campos id e type.
+type Event struct {
+	// Class Classe do recurso ou da dependência
+	Class *string `json:"class,omitempty"`
 
-	// Principal Um ativo de TI
-	Principal Vertex `json:"principal"`
+	// Healthy Estado de saúde do recurso após o evento
+	Healthy *bool `json:"healthy,omitempty"`
 
-	// Title Nome que será exibido para a sessão do grafo
-	Title string `json:"title"`
+	// Id Identificador crescente do evento, usado em Last-Event-ID
+	Id int64 `json:"id"`
 
-	// Vertices Lista de recursos que devem ser exibidos no grafo
-	Vertices []Vertex `json:"vertices"`
-}
+	// Key Chave do recurso ou da dependência
+	Key *string `json:"key,omitempty"`
 
-// Summary Um sumário sobre o estado da infraestrutura
-type Summary struct {
-	// TotalEdges O número total de relacionamentos presentes na base
-	TotalEdges int `json:"total_edges"`
+	// Source Origem da dependência, em eventos edge.*
+	Source *string `json:"source,omitempty"`
 
-	// TotalVertices O número total de recursos presentes na base
-	TotalVertices int `json:"total_vertices"`
+	// Target Destino da dependência, em eventos edge.*
+	Target *string `json:"target,omitempty"`
 
-	// UnhealthyVertices Lista de recursos não saudáveis
-	UnhealthyVertices []Vertex `json:"unhealthy_vertices"`
+	// Time Momento do evento
+	Time time.Time `json:"time"`
+
+	// Type Tipo do evento: vertex.healthy, vertex.unhealthy, vertex.created, vertex.updated, vertex.deleted, edge.created, edge.updated, edge.deleted ou graph.imported
+	Type string `json:"type"`
 }
 
-// Vertex Um ativo de TI
-type Vertex struct {
-	// Class Classe do ativo
-	Class string `json:"class"`
+// GraphPath Um caminho ordenado da origem ao destino
+type GraphPath struct {
+	// Healthy Verdadeiro se todos os recursos do caminho estão saudáveis
+	Healthy bool `json:"healthy"`
 
-	// Healthy Saúde do recurso. Um recurso pode não estar saudável por causa de um de suas dependências.
+	// Hops Recursos do caminho, da origem ao destino
+	Hops []PathHop `json:"hops"`
+
+	// Length Número de dependências do caminho
+	Length int `json:"length"`
+}
+
+// HealthChange Uma transição de saúde de um recurso
+type HealthChange struct {
+	// Healthy Estado após a transição
 	Healthy bool `json:"healthy"`
 
-	// Key identificador único do recurso
-	Key string `json:"key"`
+	// PreviousHealthy Estado antes da transição
+	PreviousHealthy bool `json:"previous_healthy"`
 
-	// Label Nome que será exibido
-	Label string `json:"label"`
+	// PreviousStatus Estado de saúde de um recurso. healthy e degraded contam como saudáveis; down e unknown, como não saudáveis.
+	PreviousStatus HealthStatus `json:"previous_status"`
 
-	// LastCheck O timestamp da última verificação
-	LastCheck string `json:"last_check"`
+	// Reason Motivo informado
+	Reason *string `json:"reason,omitempty"`
+
+	// Severity Severidade do problema informado
+	Severity *Severity `json:"severity,omitempty"`
+
+	// Source Quem informou a mudança: o subject do chamador ou anonymous
+	Source string `json:"source"`
+
+	// Status Estado de saúde de um recurso. healthy e degraded contam como saudáveis; down e unknown, como não saudáveis.
+	Status HealthStatus `json:"status"`
+
+	// Time Momento da transição
+	Time time.Time `json:"time"`
 }
 
-// VertexAttrubutes Lista de atributos do recurso
-type VertexAttrubutes = []struct {
-	// Description Descrição do atributo
-	Description string `json:"description"`
+// HealthDiff Um recurso cuja saúde muda na simulação
+type HealthDiff struct {
+	// After Saúde na simulação
+	After bool `json:"after"`
 
-	// Type Tipo do atributo
-	Type string `json:"type"`
+	// Before Saúde atual, com as falhas já propagadas aos dependentes como na simulação
+	Before bool `json:"before"`
 
-	// Value Valor do atributo, que pode ser string, número ou booleano
-	Value VertexAttrubutes_Value `json:"value"`
+	// Vertex Um ativo de TI
+	Vertex Vertex `json:"vertex"`
 }
 
-// VertexAttrubutesValue0 defines model for .
-type VertexAttrubutesValue0 = string
+// HealthHistory Transições de saúde de um recurso, da mais antiga para a mais recente
+type HealthHistory = []HealthChange
 
-// VertexAttrubutesValue1 defines model for .
-type VertexAttrubutesValue1 = int
+// HealthOrigin direct quando o estado é do próprio recurso; inherited quando o recurso só não está saudável porque uma de suas dependências não está
+type HealthOrigin string
 
-// VertexAttrubutesValue2 defines model for .
-type VertexAttrubutesValue2 = bool
+// HealthReport defines model for HealthReport.
+type HealthReport struct {
+	// Reason Motivo da mudança, guardado no histórico de saúde. Substitui o parâmetro reason.
+	Reason *string `json:"reason,omitempty"`
 
-// VertexAttrubutes_Value Valor do atributo, que pode ser string, número ou booleano
-type VertexAttrubutes_Value struct {
-	union json.RawMessage
+	// Severity Severidade do problema informado
+	Severity *Severity `json:"severity,omitempty"`
+
+	// Status Estado do recurso. Padrão: down. healthy não é aceito; use a marcação de recurso saudável.
+	Status *HealthStatus `json:"status,omitempty"`
 }
 
-// Key defines model for key.
-type Key = string
+// HealthStatus Estado de saúde de um recurso. healthy e degraded contam como saudáveis; down e unknown, como não saudáveis.
+type HealthStatus string
 
-// InternalServerError defines model for InternalServerError.
-type InternalServerError struct {
-	// Code Código do erro
-	Code int `json:"code"`
+// Impact Recursos afetados se o recurso consultado falhar. Os recursos das classes críticas aparecem em highlights.
+type Impact struct {
+	// ByClass Recursos afetados por classe, da maior para a menor
+	ByClass []ImpactClassGroup `json:"by_class"`
 
-	// Error Mensagem de erro
-	Error string `json:"error"`
-}
+	// ByDistance Recursos afetados por distância, da menor para a maior
+	ByDistance []ImpactDistanceGroup `json:"by_distance"`
 
-// InvalidRequest defines model for InvalidRequest.
-type InvalidRequest struct {
-	// Code Código do erro
-	Code int `json:"code"`
+	// Critical Quantidade de recursos afetados das classes críticas
+	Critical int `json:"critical"`
 
-	// Error Mensagem de erro
-	Error string `json:"error"`
-}
+	// Subgraph Recursos e seus relacionamentos apresentados de forma útil para consumo e visualização.
+	Subgraph Subgraph `json:"subgraph"`
 
-// NotFound defines model for NotFound.
-type NotFound struct {
-	// Code Código do erro
-	Code int `json:"code"`
+	// Total Quantidade de recursos afetados, sem contar o consultado
+	Total int `json:"total"`
 
-	// Error Mensagem de erro
-	Error string `json:"error"`
+	// Unhealthy Quantidade de recursos afetados que já estão não saudáveis
+	Unhealthy int `json:"unhealthy"`
 }
 
-// Unauthorized defines model for Unauthorized.
-type Unauthorized struct {
-	// Code Código do erro
-	Code int `json:"code"`
+// ImpactClassGroup defines model for ImpactClassGroup.
+type ImpactClassGroup struct {
+	// Class Classe dos recursos
+	Class string `json:"class"`
 
-	// Error Mensagem de erro
-	Error string `json:"error"`
-}
+	// Count Quantidade de recursos
+	Count int `json:"count"`
 
-// GetVertexDependenciesParams defines parameters for GetVertexDependencies.
-type GetVertexDependenciesParams struct {
-	// All Se verdadeiro, retorna todas as dependências do recurso, mesmo que não estejam conectadas diretamente.
-	All *bool `form:"all,omitempty" json:"all,omitempty"`
+	// Keys Chaves dos recursos do grupo
+	Keys []string `json:"keys"`
 }
 
-// GetVertexDependentsParams defines parameters for GetVertexDependents.
-type GetVertexDependentsParams struct {
-	// All Se verdadeiro, retorna todos os dependentes do recurso, mesmo que não estejam conectados diretamente.
-	All *bool `form:"all,omitempty" json:"all,omitempty"`
-}
+// ImpactDistanceGroup defines model for ImpactDistanceGroup.
+type ImpactDistanceGroup struct {
+	// Count Quantidade de recursos
+	Count int `json:"count"`
 
-// AsVertexAttrubutesValue0 returns the union data inside the VertexAttrubutes_Value as a VertexAttrubutesValue0
-func (t VertexAttrubutes_Value) AsVertexAttrubutesValue0() (VertexAttrubutesValue0, error) {
-	var body VertexAttrubutesValue0
-	err := json.Unmarshal(t.union, &body)
-	return body, err
-}
+	// Distance Menor número de saltos até o recurso consultado
+	Distance int `json:"distance"`
 
-// FromVertexAttrubutesValue0 overwrites any union data inside the VertexAttrubutes_Value as the provided VertexAttrubutesValue0
-func (t *VertexAttrubutes_Value) FromVertexAttrubutesValue0(v VertexAttrubutesValue0) error {
-	b, err := json.Marshal(v)
-	t.union = b
-	return err
+	// Keys Chaves dos recursos do grupo
+	Keys []string `json:"keys"`
 }
 
-// MergeVertexAttrubutesValue0 performs a merge with any union data inside the VertexAttrubutes_Value, using the provided VertexAttrubutesValue0
-func (t *VertexAttrubutes_Value) MergeVertexAttrubutesValue0(v VertexAttrubutesValue0) error {
-	b, err := json.Marshal(v)
-	if err != nil {
-		return err
-	}
+// ImpactedVertex defines model for ImpactedVertex.
+type ImpactedVertex struct {
+	// Dependents Quantidade de recursos que dependem dele, direta ou indiretamente
+	Dependents int `json:"dependents"`
 
-	merged, err := runtime.JSONMerge(t.union, b)
-	t.union = merged
-	return err
+	// Vertex Um ativo de TI
+	Vertex Vertex `json:"vertex"`
 }
 
-// AsVertexAttrubutesValue1 returns the union data inside the VertexAttrubutes_Value as a VertexAttrubutesValue1
-func (t VertexAttrubutes_Value) AsVertexAttrubutesValue1() (VertexAttrubutesValue1, error) {
-	var body VertexAttrubutesValue1
-	err := json.Unmarshal(t.union, &body)
-	return body, err
-}
+// ImportError Um item que não pôde ser importado
+type ImportError struct {
+	// Error Motivo da rejeição
+	Error string `json:"error"`
 
-// FromVertexAttrubutesValue1 overwrites any union data inside the VertexAttrubutes_Value as the provided VertexAttrubutesValue1
-func (t *VertexAttrubutes_Value) FromVertexAttrubutesValue1(v VertexAttrubutesValue1) error {
-	b, err := json.Marshal(v)
-	t.union = b
-	return err
-}
+	// Key Identificador do item, quando conhecido
+	Key string `json:"key"`
 
-// MergeVertexAttrubutesValue1 performs a merge with any union data inside the VertexAttrubutes_Value, using the provided VertexAttrubutesValue1
-func (t *VertexAttrubutes_Value) MergeVertexAttrubutesValue1(v VertexAttrubutesValue1) error {
-	b, err := json.Marshal(v)
-	if err != nil {
-		return err
-	}
+	// Kind Tipo do item: vertex ou edge
+	Kind string `json:"kind"`
 
-	merged, err := runtime.JSONMerge(t.union, b)
-	t.union = merged
-	return err
+	// Row Posição do item na sua lista de origem, começando em 1. Em CSV o cabeçalho não conta; o principal de um subgrafo JSON, que fica fora da lista, é a linha 0
+	Row int `json:"row"`
 }
 
-// AsVertexAttrubutesValue2 returns the union data inside the VertexAttrubutes_Value as a VertexAttrubutesValue2
-func (t VertexAttrubutes_Value) AsVertexAttrubutesValue2() (VertexAttrubutesValue2, error) {
-	var body VertexAttrubutesValue2
-	err := json.Unmarshal(t.union, &body)
-	return body, err
-}
+// ImportReport Resultado de uma importação. Itens inválidos são rejeitados individualmente sem interromper o restante do lote.
+type ImportReport struct {
+	// EdgesCreated Relacionamentos criados
+	EdgesCreated int `json:"edges_created"`
 
-// FromVertexAttrubutesValue2 overwrites any union data inside the VertexAttrubutes_Value as the provided VertexAttrubutesValue2
-func (t *VertexAttrubutes_Value) FromVertexAttrubutesValue2(v VertexAttrubutesValue2) error {
-	b, err := json.Marshal(v)
-	t.union = b
-	return err
+	// EdgesRemoved Relacionamentos removidos no modo replace
+	EdgesRemoved int `json:"edges_removed"`
+
+	// EdgesUpdated Relacionamentos existentes atualizados
+	EdgesUpdated int `json:"edges_updated"`
+
+	// Errors Itens rejeitados
+	Errors []ImportError `json:"errors"`
+
+	// Mode Modo utilizado na importação
+	Mode string `json:"mode"`
+
+	// VerticesCreated Recursos criados
+	VerticesCreated int `json:"vertices_created"`
+
+	// VerticesRemoved Recursos removidos no modo replace
+	VerticesRemoved int `json:"vertices_removed"`
+
+	// VerticesUpdated Recursos existentes atualizados
+	VerticesUpdated int `json:"vertices_updated"`
 }
 
-// MergeVertexAttrubutesValue2 performs a merge with any union data inside the VertexAttrubutes_Value, using the provided VertexAttrubutesValue2
-func (t *VertexAttrubutes_Value) MergeVertexAttrubutesValue2(v VertexAttrubutesValue2) error {
-	b, err := json.Marshal(v)
-	if err != nil {
-		return err
-	}
+// NewEdge Dados para criação de um relacionamento. O identificador é formado por origem e destino.
+type NewEdge struct {
+	// Class Classe ou Categoria do relacionamento
+	Class string `json:"class"`
 
-	merged, err := runtime.JSONMerge(t.union, b)
-	t.union = merged
-	return err
+	// Label Nome do relacionamento que será exibido
+	Label string `json:"label"`
+
+	// Source Identificador do recurso de origem, que depende do destino
+	Source string `json:"source"`
+
+	// Target Identificador do recurso de destino
+	Target string `json:"target"`
 }
 
-func (t VertexAttrubutes_Value) MarshalJSON() ([]byte, error) {
-	b, err := t.union.MarshalJSON()
-	return b, err
+// NewRedundancyGroup defines model for NewRedundancyGroup.
+type NewRedundancyGroup struct {
+	// Members Chaves dos membros. Um recurso participa de no máximo um grupo.
+	Members []string `json:"members"`
+
+	// Policy Política do grupo. Padrão: all
+	Policy *PropagationPolicy `json:"policy,omitempty"`
 }
 
-func (t *VertexAttrubutes_Value) UnmarshalJSON(b []byte) error {
-	err := t.union.UnmarshalJSON(b)
-	return err
+// NewVertex Dados para criação de um recurso
+type NewVertex struct {
+	// Class Classe do ativo
+	Class string `json:"class"`
+
+	// Healthy Saúde inicial do recurso. O padrão é saudável.
+	Healthy *bool `json:"healthy,omitempty"`
+
+	// Key identificador único do recurso
+	Key string `json:"key"`
+
+	// Label Nome que será exibido
+	Label string `json:"label"`
 }
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Resumo da infraestrutura
-	// (GET /summary)
-	Summary(w http.ResponseWriter, r *http.Request)
-	// Limpar status de saúde
-	// (POST /vertices/clear-health-status)
-	ClearHealthStatus(w http.ResponseWriter, r *http.Request)
-	// Detalhes de um recurso
-	// (GET /vertices/{key})
-	GetVertex(w http.ResponseWriter, r *http.Request, key Key)
-	// Atributos de um recurso
-	// (GET /vertices/{key}/attributes)
-	GetVertexAttributes(w http.ResponseWriter, r *http.Request, key Key)
-	// Dependencias de um recurso
-	// (GET /vertices/{key}/dependencies)
-	GetVertexDependencies(w http.ResponseWriter, r *http.Request, key Key, params GetVertexDependenciesParams)
-	// Recursos dependentes
-	// (GET /vertices/{key}/dependents)
-	GetVertexDependents(w http.ResponseWriter, r *http.Request, key Key, params GetVertexDependentsParams)
-	// Marcar recurso como não saudável
-	// (DELETE /vertices/{key}/healthy)
-	MarkVertexUnhealthy(w http.ResponseWriter, r *http.Request, key Key)
-	// Marcar recurso como saudável
-	// (POST /vertices/{key}/healthy)
-	MarkVertexHealthy(w http.ResponseWriter, r *http.Request, key Key)
-	// Vizinhos
-	// (GET /vertices/{key}/neighbors)
-	GetVertexNeighbors(w http.ResponseWriter, r *http.Request, key Key)
-	// Caminho entre dois recursos
-	// (GET /vertices/{key}/path/{target})
-	GetPath(w http.ResponseWriter, r *http.Request, key Key, target string)
-}
+// NewWebhook Dados para criar ou substituir uma assinatura de webhook
+type NewWebhook struct {
+	// Class Entrega apenas eventos destas classes
+	Class *[]string `json:"class,omitempty"`
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
-}
+	// DependentsOf Entrega apenas eventos dos recursos que dependem, direta ou indiretamente, do recurso informado. Os eventos do próprio recurso não são entregues.
+	DependentsOf *string `json:"dependents_of,omitempty"`
 
-type MiddlewareFunc func(http.Handler) http.Handler
+	// Events Tipos de evento entregues. Padrão: vertex.unhealthy e vertex.healthy
+	Events *[]string `json:"events,omitempty"`
 
-// Summary operation middleware
-func (siw *ServerInterfaceWrapper) Summary(w http.ResponseWriter, r *http.Request) {
+	// Key Entrega apenas eventos destes recursos
+	Key *[]string `json:"key,omitempty"`
 
-	ctx := r.Context()
+	// Secret Segredo usado para assinar as entregas com HMAC-SHA256. Não é retornado pela API.
+	Secret string `json:"secret"`
 
-	ctx = context.WithValue(ctx, BearerHttpAuthenticationScopes, []string{})
+	// Url Endereço que recebe os eventos por POST
+	Url string `json:"url"`
+}
 
-	r = r.WithContext(ctx)
+// NoPath defines model for NoPath.
+type NoPath struct {
+	// Code Código do erro
+	Code int `json:"code"`
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.Summary(w, r)
-	}))
+	// Error Mensagem de erro
+	Error string `json:"error"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// Source Recurso de origem
+	Source string `json:"source"`
 
-	handler.ServeHTTP(w, r)
+	// Target Recurso de destino
+	Target string `json:"target"`
 }
 
-// ClearHealthStatus operation middleware
-func (siw *ServerInterfaceWrapper) ClearHealthStatus(w http.ResponseWriter, r *http.Request) {
+// Orphans defines model for Orphans.
+type Orphans struct {
+	// Items Recursos sem nenhuma dependência, em ordem de chave
+	Items []Vertex `json:"items"`
 
-	ctx := r.Context()
+	// Total Quantidade de recursos sem dependências
+	Total int `json:"total"`
+}
 
-	ctx = context.WithValue(ctx, BearerHttpAuthenticationScopes, []string{})
+// Path defines model for Path.
+type Path struct {
+	// All Se verdadeiro, retorna todos os itens do grafo, mesmo que não estejam conectados diretamente.
+	All bool `json:"all"`
 
-	r = r.WithContext(ctx)
+	// Edges Lista de relacionamentos que devem ser exibidos no grafo
+	Edges []Edge `json:"edges"`
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ClearHealthStatus(w, r)
-	}))
+	// Highlights Elementos que devem ser destacados no grafo
+	Highlights []Vertex `json:"highlights"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// Hops Saltos do caminho mais curto, da origem ao destino
+	Hops []PathHop `json:"hops"`
 
-	handler.ServeHTTP(w, r)
-}
+	// Principal Um ativo de TI
+	Principal Vertex `json:"principal"`
 
-// GetVertex operation middleware
-func (siw *ServerInterfaceWrapper) GetVertex(w http.ResponseWriter, r *http.Request) {
+	// Title Nome que será exibido para a sessão do grafo
+	Title string `json:"title"`
 
-	var err error
+	// Vertices Lista de recursos que devem ser exibidos no grafo
+	Vertices []Vertex `json:"vertices"`
+}
 
-	// ------------- Path parameter "key" -------------
-	var key Key
+// PathHop Um recurso do caminho e a dependência seguida a partir dele
+type PathHop struct {
+	// Distance Distância acumulada da origem até o recurso, em saltos
+	Distance int `json:"distance"`
 
-	err = runtime.BindStyledParameterWithOptions("simple", "key", r.PathValue("key"), &key, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "key", Err: err})
-		return
-	}
+	// Edge Dependência seguida até o próximo recurso. Ausente no último salto.
+	Edge *Edge `json:"edge,omitempty"`
 
-	ctx := r.Context()
+	// Index Posição do recurso no caminho, começando em 0 na origem
+	Index int `json:"index"`
 
-	ctx = context.WithValue(ctx, BearerHttpAuthenticationScopes, []string{})
+	// Vertex Um ativo de TI
+	Vertex Vertex `json:"vertex"`
+}
 
-	r = r.WithContext(ctx)
+// Paths Caminhos entre dois recursos. Uma lista vazia indica que não há caminho que atenda aos critérios.
+type Paths struct {
+	// Mode Modo usado na busca
+	Mode string `json:"mode"`
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetVertex(w, r, key)
-	}))
+	// Paths Caminhos encontrados, do mais curto para o mais longo
+	Paths []GraphPath `json:"paths"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// Source Recurso de origem
+	Source string `json:"source"`
 
-	handler.ServeHTTP(w, r)
+	// Target Recurso de destino
+	Target string `json:"target"`
 }
 
-// GetVertexAttributes operation middleware
-func (siw *ServerInterfaceWrapper) GetVertexAttributes(w http.ResponseWriter, r *http.Request) {
-
-	var err error
-
-	// ------------- Path parameter "key" -------------
-	var key Key
+// PropagationMode Como as falhas das partes se propagam. any: qualquer parte com falha propaga. all: só propaga quando todas falham. k_of_n: propaga quando menos de k partes estão saudáveis. ignore: nunca propaga.
+type PropagationMode string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "key", r.PathValue("key"), &key, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "key", Err: err})
-		return
-	}
+// PropagationPolicy defines model for PropagationPolicy.
+type PropagationPolicy struct {
+	// K Quantidade mínima de partes saudáveis no modo k_of_n. Quando há menos partes que k, todas precisam estar saudáveis.
+	K *int `json:"k,omitempty"`
 
-	ctx := r.Context()
+	// Mode Como as falhas das partes se propagam. any: qualquer parte com falha propaga. all: só propaga quando todas falham. k_of_n: propaga quando menos de k partes estão saudáveis. ignore: nunca propaga.
+	Mode PropagationMode `json:"mode"`
+}
 
-	ctx = context.WithValue(ctx, BearerHttpAuthenticationScopes, []string{})
+// RedundancyGroup Recursos redundantes entre si. Para quem depende deles, os membros do grupo contam como uma parte só, saudável conforme a política do grupo.
+type RedundancyGroup struct {
+	// Members Chaves dos membros, em ordem
+	Members []string `json:"members"`
 
-	r = r.WithContext(ctx)
+	// Name Nome do grupo
+	Name   string            `json:"name"`
+	Policy PropagationPolicy `json:"policy"`
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetVertexAttributes(w, r, key)
-	}))
+// ResolvedPropagation A política que decide se as falhas das dependências tornam o recurso não saudável. As dependências do mesmo grupo de redundância contam como uma parte só.
+type ResolvedPropagation struct {
+	// Group Grupo de redundância do qual o recurso faz parte
+	Group *string `json:"group,omitempty"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// Key Chave do recurso
+	Key    string            `json:"key"`
+	Policy PropagationPolicy `json:"policy"`
 
-	handler.ServeHTTP(w, r)
+	// Source De onde vem a política: do próprio recurso, da sua classe ou o padrão any
+	Source ResolvedPropagationSource `json:"source"`
 }
 
-// GetVertexDependencies operation middleware
-func (siw *ServerInterfaceWrapper) GetVertexDependencies(w http.ResponseWriter, r *http.Request) {
+// ResolvedPropagationSource De onde vem a política: do próprio recurso, da sua classe ou o padrão any
+type ResolvedPropagationSource string
 
-	var err error
+// RootCause Candidatos a causa raiz da falha de um recurso. O subgrafo contém os caminhos até os candidatos, que aparecem em highlights.
+type RootCause struct {
+	// Candidates Candidatos, do mais provável para o menos provável
+	Candidates []RootCauseCandidate `json:"candidates"`
 
-	// ------------- Path parameter "key" -------------
-	var key Key
+	// Subgraph Recursos e seus relacionamentos apresentados de forma útil para consumo e visualização.
+	Subgraph Subgraph `json:"subgraph"`
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "key", r.PathValue("key"), &key, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "key", Err: err})
-		return
-	}
+// RootCauseCandidate Uma dependência não saudável cujas próprias dependências estão saudáveis
+type RootCauseCandidate struct {
+	// Depth Menor número de saltos entre o recurso consultado e o candidato
+	Depth int `json:"depth"`
 
-	ctx := r.Context()
+	// Explains Quantos recursos não saudáveis do caminho dependem do candidato, incluindo o recurso consultado
+	Explains int `json:"explains"`
 
-	ctx = context.WithValue(ctx, BearerHttpAuthenticationScopes, []string{})
+	// Paths Caminhos do recurso consultado até o candidato, como listas de chaves. Limitado a 10 caminhos.
+	Paths [][]string `json:"paths"`
 
-	r = r.WithContext(ctx)
+	// Rank Posição do candidato, começando em 1
+	Rank int `json:"rank"`
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetVertexDependenciesParams
+	// Vertex Um ativo de TI
+	Vertex Vertex `json:"vertex"`
+}
 
-	// ------------- Optional query parameter "all" -------------
+// Severity Severidade do problema informado
+type Severity string
 
-	err = runtime.BindQueryParameter("form", true, false, "all", r.URL.Query(), &params.All)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "all", Err: err})
-		return
-	}
+// SeverityCounts defines model for SeverityCounts.
+type SeverityCounts struct {
+	// Critical Quantidade de recursos com severidade critical
+	Critical int `json:"critical"`
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetVertexDependencies(w, r, key, params)
-	}))
+	// Major Quantidade de recursos com severidade major
+	Major int `json:"major"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// Minor Quantidade de recursos com severidade minor
+	Minor int `json:"minor"`
 
-	handler.ServeHTTP(w, r)
+	// Warning Quantidade de recursos com severidade warning
+	Warning int `json:"warning"`
 }
 
-// GetVertexDependents operation middleware
-func (siw *ServerInterfaceWrapper) GetVertexDependents(w http.ResponseWriter, r *http.Request) {
-
-	var err error
+// SimulationRequest Recursos que devem falhar ou se recuperar na simulação e o recorte do grafo retornado
+type SimulationRequest struct {
+	// All Em dependencies e dependents, inclui as relações indiretas
+	All *bool `json:"all,omitempty"`
 
-	// ------------- Path parameter "key" -------------
-	var key Key
+	// Fail Recursos marcados como não saudáveis na simulação
+	Fail *[]string `json:"fail,omitempty"`
 
-	err = runtime.BindStyledParameterWithOptions("simple", "key", r.PathValue("key"), &key, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "key", Err: err})
-		return
-	}
+	// Key Recurso principal do recorte. Obrigatório quando scope não é graph.
+	Key *string `json:"key,omitempty"`
 
-	ctx := r.Context()
+	// Recover Recursos marcados como saudáveis na simulação
+	Recover *[]string `json:"recover,omitempty"`
 
-	ctx = context.WithValue(ctx, BearerHttpAuthenticationScopes, []string{})
+	// Scope Recorte retornado: graph (padrão), dependencies, dependents, neighbors ou path
+	Scope *string `json:"scope,omitempty"`
 
-	r = r.WithContext(ctx)
+	// Target Destino do caminho quando scope é path
+	Target *string `json:"target,omitempty"`
+}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetVertexDependentsParams
+// SimulationResult Saúde calculada sobre uma cópia do grafo. A falha de um recurso torna não saudáveis todos os que dependem dele. O estado real não é alterado.
+type SimulationResult struct {
+	// BecameHealthy Quantidade de recursos que voltam a estar saudáveis
+	BecameHealthy int `json:"became_healthy"`
 
-	// ------------- Optional query parameter "all" -------------
+	// BecameUnhealthy Quantidade de recursos que deixam de estar saudáveis
+	BecameUnhealthy int `json:"became_unhealthy"`
 
-	err = runtime.BindQueryParameter("form", true, false, "all", r.URL.Query(), &params.All)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "all", Err: err})
-		return
-	}
+	// Changes Recursos de todo o grafo cuja saúde muda, por chave
+	Changes []HealthDiff `json:"changes"`
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetVertexDependents(w, r, key, params)
-	}))
+	// Subgraph Recursos e seus relacionamentos apresentados de forma útil para consumo e visualização.
+	Subgraph Subgraph `json:"subgraph"`
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// SinglePointsOfFailure defines model for SinglePointsOfFailure.
+type SinglePointsOfFailure struct {
+	// ArticulationPoints Recursos cuja remoção desconecta o grafo
+	ArticulationPoints []ArticulationPoint `json:"articulation_points"`
 
-	handler.ServeHTTP(w, r)
+	// Bridges Dependências cuja remoção desconecta o grafo
+	Bridges []Edge `json:"bridges"`
 }
 
-// MarkVertexUnhealthy operation middleware
-func (siw *ServerInterfaceWrapper) MarkVertexUnhealthy(w http.ResponseWriter, r *http.Request) {
+// StartupOrder Ondas de inicialização em que cada recurso vem depois de todas as suas dependências. O desligamento segue as ondas em ordem inversa.
+type StartupOrder struct {
+	// Cycles Ciclos de dependência. Os recursos de um ciclo ficam juntos na mesma onda
+	Cycles []Cycle `json:"cycles"`
 
-	var err error
+	// Ordered Falso quando algum ciclo impede uma ordem estrita
+	Ordered bool `json:"ordered"`
 
-	// ------------- Path parameter "key" -------------
-	var key Key
+	// Subgraph Recursos e seus relacionamentos apresentados de forma útil para consumo e visualização.
+	Subgraph Subgraph `json:"subgraph"`
 
-	err = runtime.BindStyledParameterWithOptions("simple", "key", r.PathValue("key"), &key, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "key", Err: err})
-		return
-	}
+	// Waves Ondas de inicialização, das dependências aos dependentes
+	Waves []StartupWave `json:"waves"`
+}
 
-	ctx := r.Context()
+// StartupWave defines model for StartupWave.
+type StartupWave struct {
+	// Vertices Recursos que podem ser iniciados em paralelo nesta onda
+	Vertices []Vertex `json:"vertices"`
 
-	ctx = context.WithValue(ctx, BearerHttpAuthenticationScopes, []string{})
+	// Wave Posição da onda, começando em 0
+	Wave int `json:"wave"`
+}
 
-	r = r.WithContext(ctx)
+// StatusCounts defines model for StatusCounts.
+type StatusCounts struct {
+	// Degraded Quantidade de recursos no estado degraded
+	Degraded int `json:"degraded"`
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.MarkVertexUnhealthy(w, r, key)
-	}))
+	// Down Quantidade de recursos no estado down
+	Down int `json:"down"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// Healthy Quantidade de recursos no estado healthy
+	Healthy int `json:"healthy"`
 
-	handler.ServeHTTP(w, r)
+	// Unknown Quantidade de recursos no estado unknown
+	Unknown int `json:"unknown"`
 }
 
-// MarkVertexHealthy operation middleware
-func (siw *ServerInterfaceWrapper) MarkVertexHealthy(w http.ResponseWriter, r *http.Request) {
+// Subgraph Recursos e seus relacionamentos apresentados de forma útil para consumo e visualização.
+type Subgraph struct {
+	// All Se verdadeiro, retorna todos os itens do grafo, mesmo que não estejam conectados diretamente.
+	All bool `json:"all"`
 
-	var err error
+	// Edges Lista de relacionamentos que devem ser exibidos no grafo
+	Edges []Edge `json:"edges"`
 
-	// ------------- Path parameter "key" -------------
-	var key Key
+	// Highlights Elementos que devem ser destacados no grafo
+	Highlights []Vertex `json:"highlights"`
 
-	err = runtime.BindStyledParameterWithOptions("simple", "key", r.PathValue("key"), &key, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "key", Err: err})
-		return
-	}
+	// Principal Um ativo de TI
+	Principal Vertex `json:"principal"`
 
-	ctx := r.Context()
+	// Title Nome que será exibido para a sessão do grafo
+	Title string `json:"title"`
 
-	ctx = context.WithValue(ctx, BearerHttpAuthenticationScopes, []string{})
+	// Vertices Lista de recursos que devem ser exibidos no grafo
+	Vertices []Vertex `json:"vertices"`
+}
 
-	r = r.WithContext(ctx)
+// Summary Um sumário sobre o estado da infraestrutura
+type Summary struct {
+	// Classes Quantidade de recursos por classe, das classes com mais recursos não saudáveis às com menos
+	Classes []ClassSummary `json:"classes"`
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.MarkVertexHealthy(w, r, key)
-	}))
+	// DirectUnhealthy Quantidade de recursos não saudáveis por falha própria
+	DirectUnhealthy int `json:"direct_unhealthy"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// HealthyRatio Fração de recursos saudáveis, de 0 a 1
+	HealthyRatio float64 `json:"healthy_ratio"`
 
-	handler.ServeHTTP(w, r)
-}
+	// InheritedUnhealthy Quantidade de recursos não saudáveis apenas por causa de suas dependências
+	InheritedUnhealthy int            `json:"inherited_unhealthy"`
+	Severities         SeverityCounts `json:"severities"`
 
-// GetVertexNeighbors operation middleware
-func (siw *ServerInterfaceWrapper) GetVertexNeighbors(w http.ResponseWriter, r *http.Request) {
+	// StaleAfter Segundos sem verificação a partir dos quais um recurso é considerado desatualizado
+	StaleAfter int `json:"stale_after"`
 
-	var err error
+	// StaleTotal O número total de recursos desatualizados, incluindo os nunca verificados
+	StaleTotal int `json:"stale_total"`
 
-	// ------------- Path parameter "key" -------------
-	var key Key
+	// StaleVertices Recursos cuja última verificação é mais antiga que stale_after, dos mais antigos aos mais recentes, limitada pelo parâmetro limit. Para recursos sem relato vale a última verificação do grafo.
+	StaleVertices []Vertex     `json:"stale_vertices"`
+	Statuses      StatusCounts `json:"statuses"`
 
-	err = runtime.BindStyledParameterWithOptions("simple", "key", r.PathValue("key"), &key, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "key", Err: err})
-		return
-	}
+	// TopImpacted Recursos com mais dependentes
+	TopImpacted []ImpactedVertex `json:"top_impacted"`
 
-	ctx := r.Context()
-
-	ctx = context.WithValue(ctx, BearerHttpAuthenticationScopes, []string{})
+	// TotalEdges O número total de relacionamentos presentes na base
+	TotalEdges int `json:"total_edges"`
 
-	r = r.WithContext(ctx)
+	// TotalVertices O número total de recursos presentes na base
+	TotalVertices int `json:"total_vertices"`
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetVertexNeighbors(w, r, key)
-	}))
+	// UnhealthyNext Caminho da listagem paginada com os demais recursos não saudáveis. Ausente quando a lista está completa.
+	UnhealthyNext *string `json:"unhealthy_next,omitempty"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// UnhealthyTotal O número total de recursos não saudáveis da origem pedida
+	UnhealthyTotal int `json:"unhealthy_total"`
 
-	handler.ServeHTTP(w, r)
+	// UnhealthyVertices Lista de recursos não saudáveis, em ordem de chave, limitada pelo parâmetro limit
+	UnhealthyVertices []Vertex `json:"unhealthy_vertices"`
 }
 
-// GetPath operation middleware
-func (siw *ServerInterfaceWrapper) GetPath(w http.ResponseWriter, r *http.Request) {
+// Vertex Um ativo de TI
+type Vertex struct {
+	// Class Classe do ativo
+	Class string `json:"class"`
 
-	var err error
+	// HealthOrigin direct quando o estado é do próprio recurso; inherited quando o recurso só não está saudável porque uma de suas dependências não está
+	HealthOrigin HealthOrigin `json:"health_origin"`
 
-	// ------------- Path parameter "key" -------------
-	var key Key
+	// Healthy Saúde do recurso. Um recurso pode não estar saudável por causa de um de suas dependências.
+	Healthy bool `json:"healthy"`
 
-	err = runtime.BindStyledParameterWithOptions("simple", "key", r.PathValue("key"), &key, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "key", Err: err})
-		return
-	}
+	// InheritedFrom Chave da dependência mais próxima que falhou diretamente, quando o estado é herdado. Se essa dependência se recupera, a falha propagada continua apontando para ela até o recurso informar sua saúde.
+	InheritedFrom *string `json:"inherited_from,omitempty"`
 
-	// ------------- Path parameter "target" -------------
-	var target string
+	// Key identificador único do recurso
+	Key string `json:"key"`
 
-	err = runtime.BindStyledParameterWithOptions("simple", "target", r.PathValue("target"), &target, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "target", Err: err})
-		return
-	}
+	// Label Nome que será exibido
+	Label string `json:"label"`
 
-	ctx := r.Context()
+	// LastCheck Momento do último relato de saúde do recurso, vazio se nunca houve
+	LastCheck string `json:"last_check"`
 
-	ctx = context.WithValue(ctx, BearerHttpAuthenticationScopes, []string{})
+	// Reason Motivo do último relato de problema
+	Reason *string `json:"reason,omitempty"`
 
-	r = r.WithContext(ctx)
+	// Severity Severidade do problema informado
+	Severity *Severity `json:"severity,omitempty"`
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetPath(w, r, key, target)
-	}))
+	// Status Estado de saúde de um recurso. healthy e degraded contam como saudáveis; down e unknown, como não saudáveis.
+	Status HealthStatus `json:"status"`
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// VertexAttribute Um atributo tipado de um recurso. A descrição identifica o atributo dentro do recurso.
+type VertexAttribute struct {
+	// Description Descrição do atributo
+	Description string `json:"description"`
 
-	handler.ServeHTTP(w, r)
-}
+	// Type Tipo do atributo: string, integer, boolean ou link
+	Type string `json:"type"`
 
-type UnescapedCookieParamError struct {
-	ParamName string
-	Err       error
+	// Value Valor do atributo, que pode ser string, número ou booleano
+	Value VertexAttrubutesValue `json:"value"`
 }
 
-func (e *UnescapedCookieParamError) Error() string {
-	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
-}
+// VertexAttrubutes Lista de atributos do recurso
+type VertexAttrubutes = []VertexAttribute
 
-func (e *UnescapedCookieParamError) Unwrap() error {
-	return e.Err
-}
+// VertexAttrubutesValue Valor do atributo, que pode ser string, número ou booleano
+type VertexAttrubutesValue = VertexAttrubutes_Value
 
-type UnmarshalingParamError struct {
-	ParamName string
-	Err       error
-}
+// VertexAttrubutesValue0 defines model for .
+type VertexAttrubutesValue0 = string
 
-func (e *UnmarshalingParamError) Error() string {
-	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
-}
+// VertexAttrubutesValue1 defines model for .
+type VertexAttrubutesValue1 = int
 
-func (e *UnmarshalingParamError) Unwrap() error {
-	return e.Err
-}
+// VertexAttrubutesValue2 defines model for .
+type VertexAttrubutesValue2 = bool
 
-type RequiredParamError struct {
-	ParamName string
+// VertexAttrubutes_Value Valor do atributo, que pode ser string, número ou booleano
+type VertexAttrubutes_Value struct {
+	union json.RawMessage
 }
 
-func (e *RequiredParamError) Error() string {
-	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
-}
+// VertexPage Uma página de uma listagem de recursos
+type VertexPage struct {
+	// Items Recursos da página
+	Items []Vertex `json:"items"`
 
-type RequiredHeaderError struct {
-	ParamName string
-	Err       error
-}
+	// NextCursor Cursor da próxima página. Ausente na última página.
+	NextCursor *string `json:"next_cursor,omitempty"`
 
-func (e *RequiredHeaderError) Error() string {
-	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+	// Total Total de recursos que atendem aos filtros
+	Total int `json:"total"`
 }
 
-func (e *RequiredHeaderError) Unwrap() error {
-	return e.Err
-}
+// VertexUpdate Dados alteráveis de um recurso
+type VertexUpdate struct {
+	// Class Classe do ativo
+	Class string `json:"class"`
 
-type InvalidParamFormatError struct {
-	ParamName string
-	Err       error
+	// Label Nome que será exibido
+	Label string `json:"label"`
 }
 
-func (e *InvalidParamFormatError) Error() string {
-	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
-}
+// Webhook Uma assinatura que recebe eventos por POST
+type Webhook struct {
+	// Class Entrega apenas eventos destas classes
+	Class []string `json:"class"`
 
-func (e *InvalidParamFormatError) Unwrap() error {
-	return e.Err
-}
+	// CreatedAt Momento da criação
+	CreatedAt time.Time `json:"created_at"`
 
-type TooManyValuesForParamError struct {
-	ParamName string
-	Count     int
-}
+	// DependentsOf Entrega apenas eventos dos recursos que dependem, direta ou indiretamente, do recurso informado. Os eventos do próprio recurso não são entregues.
+	DependentsOf *string `json:"dependents_of,omitempty"`
 
-func (e *TooManyValuesForParamError) Error() string {
-	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
-}
+	// Events Tipos de evento entregues. Padrão: vertex.unhealthy e vertex.healthy
+	Events []string `json:"events"`
 
-// Handler creates http.Handler with routing matching OpenAPI spec.
-func Handler(si ServerInterface) http.Handler {
-	return HandlerWithOptions(si, StdHTTPServerOptions{})
-}
+	// Id Identificador da assinatura
+	Id string `json:"id"`
 
-// ServeMux is an abstraction of http.ServeMux.
-type ServeMux interface {
-	HandleFunc(pattern string, handler func(http.ResponseWriter, *http.Request))
-	ServeHTTP(w http.ResponseWriter, r *http.Request)
-}
+	// Key Entrega apenas eventos destes recursos
+	Key []string `json:"key"`
 
-type StdHTTPServerOptions struct {
-	BaseURL          string
-	BaseRouter       ServeMux
-	Middlewares      []MiddlewareFunc
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+	// Url Endereço que recebe os eventos por POST
+	Url string `json:"url"`
 }
 
-// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
-func HandlerFromMux(si ServerInterface, m ServeMux) http.Handler {
-	return HandlerWithOptions(si, StdHTTPServerOptions{
-		BaseRouter: m,
-	})
+// WebhookDeliveries defines model for WebhookDeliveries.
+type WebhookDeliveries = []WebhookDelivery
+
+// WebhookDelivery Uma tentativa de entregar um evento a uma assinatura. As entregas são assinadas no cabeçalho X-Opsmind-Signature com sha256=<hmac do corpo em hexadecimal>.
+type WebhookDelivery struct {
+	// Attempts Número de tentativas feitas
+	Attempts int `json:"attempts"`
+
+	// CreatedAt Momento em que o evento foi enfileirado
+	CreatedAt time.Time `json:"created_at"`
+
+	// Error Erro da última tentativa
+	Error *string `json:"error,omitempty"`
+
+	// EventId Id do evento entregue
+	EventId int64 `json:"event_id"`
+
+	// EventType Tipo do evento entregue
+	EventType string `json:"event_type"`
+
+	// Id Identificador da entrega, repetido no cabeçalho X-Opsmind-Delivery
+	Id string `json:"id"`
+
+	// ResponseCode Código HTTP da última tentativa
+	ResponseCode *int `json:"response_code,omitempty"`
+
+	// Status pending, delivered ou failed
+	Status string `json:"status"`
+
+	// UpdatedAt Momento da última tentativa
+	UpdatedAt time.Time `json:"updated_at"`
 }
 
-func HandlerFromMuxWithBaseURL(si ServerInterface, m ServeMux, baseURL string) http.Handler {
-	return HandlerWithOptions(si, StdHTTPServerOptions{
-		BaseURL:    baseURL,
-		BaseRouter: m,
-	})
+// WebhookPayload defines model for WebhookPayload.
+type WebhookPayload struct {
+	// Delivery Identificador da entrega
+	Delivery string `json:"delivery"`
+
+	// Event Uma mudança de saúde ou do grafo. É enviado como o campo data de um evento SSE cujo id e event repetem os campos id e type.
+	Event Event `json:"event"`
+
+	// Subscription Identificador da assinatura
+	Subscription string `json:"subscription"`
 }
 
-// HandlerWithOptions creates http.Handler with additional options
-func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.Handler {
-	m := options.BaseRouter
+// Webhooks defines model for Webhooks.
+type Webhooks = []Webhook
 
-	if m == nil {
-		m = http.NewServeMux()
-	}
-	if options.ErrorHandlerFunc == nil {
-		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		}
-	}
+// Depth defines model for depth.
+type Depth = int
 
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandlerFunc:   options.ErrorHandlerFunc,
-	}
+// EdgeClass defines model for edgeClass.
+type EdgeClass = []string
 
-	m.HandleFunc("GET "+options.BaseURL+"/summary", wrapper.Summary)
-	m.HandleFunc("POST "+options.BaseURL+"/vertices/clear-health-status", wrapper.ClearHealthStatus)
-	m.HandleFunc("GET "+options.BaseURL+"/vertices/{key}", wrapper.GetVertex)
-	m.HandleFunc("GET "+options.BaseURL+"/vertices/{key}/attributes", wrapper.GetVertexAttributes)
-	m.HandleFunc("GET "+options.BaseURL+"/vertices/{key}/dependencies", wrapper.GetVertexDependencies)
-	m.HandleFunc("GET "+options.BaseURL+"/vertices/{key}/dependents", wrapper.GetVertexDependents)
-	m.HandleFunc("DELETE "+options.BaseURL+"/vertices/{key}/healthy", wrapper.MarkVertexUnhealthy)
-	m.HandleFunc("POST "+options.BaseURL+"/vertices/{key}/healthy", wrapper.MarkVertexHealthy)
-	m.HandleFunc("GET "+options.BaseURL+"/vertices/{key}/neighbors", wrapper.GetVertexNeighbors)
-	m.HandleFunc("GET "+options.BaseURL+"/vertices/{key}/path/{target}", wrapper.GetPath)
+// EdgeKey defines model for edgeKey.
+type EdgeKey = string
 
-	return m
-}
+// ExcludeClass defines model for excludeClass.
+type ExcludeClass = []string
 
-type InternalServerErrorJSONResponse struct {
+// Highlight defines model for highlight.
+type Highlight = []string
+
+// IncludeClass defines model for includeClass.
+type IncludeClass = []string
+
+// Key defines model for key.
+type Key = string
+
+// StopAtClass defines model for stopAtClass.
+type StopAtClass = []string
+
+// WebhookId defines model for webhookId.
+type WebhookId = string
+
+// Forbidden defines model for Forbidden.
+type Forbidden struct {
 	// Code Código do erro
 	Code int `json:"code"`
 
@@ -764,7 +969,8 @@ type InternalServerErrorJSONResponse struct {
 	Error string `json:"error"`
 }
 
-type InvalidRequestJSONResponse struct {
+// InternalServerError defines model for InternalServerError.
+type InternalServerError struct {
 	// Code Código do erro
 	Code int `json:"code"`
 
@@ -772,7 +978,8 @@ type InvalidRequestJSONResponse struct {
 	Error string `json:"error"`
 }
 
-type NotFoundJSONResponse struct {
+// InvalidRequest defines model for InvalidRequest.
+type InvalidRequest struct {
 	// Code Código do erro
 	Code int `json:"code"`
 
@@ -780,7 +987,8 @@ type NotFoundJSONResponse struct {
 	Error string `json:"error"`
 }
 
-type UnauthorizedJSONResponse struct {
+// NotFound defines model for NotFound.
+type NotFound struct {
 	// Code Código do erro
 	Code int `json:"code"`
 
@@ -788,598 +996,7240 @@ type UnauthorizedJSONResponse struct {
 	Error string `json:"error"`
 }
 
-type SummaryRequestObject struct {
+// Unauthorized defines model for Unauthorized.
+type Unauthorized struct {
+	// Code Código do erro
+	Code int `json:"code"`
+
+	// Error Mensagem de erro
+	Error string `json:"error"`
 }
 
-type SummaryResponseObject interface {
-	VisitSummaryResponse(w http.ResponseWriter) error
+// GetCriticalVerticesParams defines parameters for GetCriticalVertices.
+type GetCriticalVerticesParams struct {
+	// Metric Métrica da classificação: in_degree (padrão) ou betweenness
+	Metric *GetCriticalVerticesParamsMetric `form:"metric,omitempty" json:"metric,omitempty"`
+
+	// Limit Quantidade máxima de recursos. Padrão: 10
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
-type Summary200JSONResponse Summary
+// GetCriticalVerticesParamsMetric defines parameters for GetCriticalVertices.
+type GetCriticalVerticesParamsMetric string
 
-func (response Summary200JSONResponse) VisitSummaryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// GetEventsParams defines parameters for GetEvents.
+type GetEventsParams struct {
+	// Key Envia apenas eventos destes recursos. Em eventos de dependência vale a origem ou o destino.
+	Key *[]string `form:"key,omitempty" json:"key,omitempty"`
 
-	return json.NewEncoder(w).Encode(response)
+	// Class Envia apenas eventos destas classes
+	Class *[]string `form:"class,omitempty" json:"class,omitempty"`
+
+	// DependentsOf Envia apenas eventos dos recursos que dependem, direta ou indiretamente, do recurso informado. Os eventos do próprio recurso não são enviados.
+	DependentsOf *string `form:"dependents_of,omitempty" json:"dependents_of,omitempty"`
+
+	// LastEventID Id do último evento recebido
+	LastEventID *string `json:"Last-Event-ID,omitempty"`
 }
 
-type Summary401JSONResponse struct{ UnauthorizedJSONResponse }
+// ExportGraphParams defines parameters for ExportGraph.
+type ExportGraphParams struct {
+	// Format Formato de saída: dot, graphml, mermaid ou cytoscape
+	Format *string `form:"format,omitempty" json:"format,omitempty"`
 
-func (response Summary401JSONResponse) VisitSummaryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	// Scope Consulta exportada: graph (padrão), dependencies, dependents, neighbors ou path
+	Scope *string `form:"scope,omitempty" json:"scope,omitempty"`
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Key Recurso principal da consulta. Obrigatório quando scope não é graph.
+	Key *string `form:"key,omitempty" json:"key,omitempty"`
 
-type Summary500JSONResponse struct {
-	InternalServerErrorJSONResponse
-}
+	// Target Recurso de destino quando scope é path
+	Target *string `form:"target,omitempty" json:"target,omitempty"`
 
-func (response Summary500JSONResponse) VisitSummaryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	// All Se verdadeiro, dependencies e dependents incluem os itens transitivos
+	All *bool `form:"all,omitempty" json:"all,omitempty"`
 
-	return json.NewEncoder(w).Encode(response)
+	// Accept Formato desejado quando `format` não é informado
+	Accept *string `json:"Accept,omitempty"`
 }
 
-type ClearHealthStatusRequestObject struct {
-}
+// ImportGraphMultipartBody defines parameters for ImportGraph.
+type ImportGraphMultipartBody struct {
+	// Edges CSV de relacionamentos
+	Edges *openapi_types.File `json:"edges,omitempty"`
 
-type ClearHealthStatusResponseObject interface {
-	VisitClearHealthStatusResponse(w http.ResponseWriter) error
+	// Vertices CSV de recursos
+	Vertices *openapi_types.File `json:"vertices,omitempty"`
 }
 
-type ClearHealthStatus200Response struct {
+// ImportGraphParams defines parameters for ImportGraph.
+type ImportGraphParams struct {
+	// Mode Modo de importação
+	Mode *ImportGraphParamsMode `form:"mode,omitempty" json:"mode,omitempty"`
 }
 
-func (response ClearHealthStatus200Response) VisitClearHealthStatusResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
+// ImportGraphParamsMode defines parameters for ImportGraph.
+type ImportGraphParamsMode string
 
-type ClearHealthStatus401JSONResponse struct{ UnauthorizedJSONResponse }
+// SummaryParams defines parameters for Summary.
+type SummaryParams struct {
+	// Limit Quantidade máxima de recursos nas listas de não saudáveis e desatualizados. Padrão: 20
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
 
-func (response ClearHealthStatus401JSONResponse) VisitClearHealthStatusResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	// StaleAfter Segundos sem verificação a partir dos quais um recurso é considerado desatualizado. Padrão: 900
+	StaleAfter *int `form:"stale_after,omitempty" json:"stale_after,omitempty"`
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Top Quantidade de recursos com mais dependentes. Padrão: 5
+	Top *int `form:"top,omitempty" json:"top,omitempty"`
 
-type ClearHealthStatus500JSONResponse struct {
-	InternalServerErrorJSONResponse
+	// Origin Lista apenas os recursos não saudáveis com esta origem. Use direct para ver só as falhas próprias.
+	Origin *HealthOrigin `form:"origin,omitempty" json:"origin,omitempty"`
 }
 
-func (response ClearHealthStatus500JSONResponse) VisitClearHealthStatusResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+// ListVerticesParams defines parameters for ListVertices.
+type ListVerticesParams struct {
+	// Class Filtra pelas classes informadas
+	Class *[]string `form:"class,omitempty" json:"class,omitempty"`
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Healthy Filtra pelo estado de saúde
+	Healthy *bool `form:"healthy,omitempty" json:"healthy,omitempty"`
 
-type GetVertexRequestObject struct {
-	Key Key `json:"key"`
-}
-
-type GetVertexResponseObject interface {
-	VisitGetVertexResponse(w http.ResponseWriter) error
-}
-
-type GetVertex200JSONResponse Vertex
+	// Label Filtra por parte do nome, sem diferenciar maiúsculas
+	Label *string `form:"label,omitempty" json:"label,omitempty"`
 
-func (response GetVertex200JSONResponse) VisitGetVertexResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// Attribute Filtra por valor de atributo no formato descrição=valor
+	Attribute *[]string `form:"attribute,omitempty" json:"attribute,omitempty"`
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Sort Ordenação: key (padrão) ou label
+	Sort *string `form:"sort,omitempty" json:"sort,omitempty"`
 
-type GetVertex401JSONResponse struct{ UnauthorizedJSONResponse }
+	// Limit Tamanho da página, entre 1 e 500
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
 
-func (response GetVertex401JSONResponse) VisitGetVertexResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	// Cursor Cursor opaco retornado pela página anterior
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
 
-	return json.NewEncoder(w).Encode(response)
+	// Origin Retorna apenas recursos com esta origem de saúde. Com healthy=false e origin=direct, retorna só as falhas próprias.
+	Origin *HealthOrigin `form:"origin,omitempty" json:"origin,omitempty"`
 }
 
-type GetVertex404JSONResponse struct{ NotFoundJSONResponse }
-
-func (response GetVertex404JSONResponse) VisitGetVertexResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
-
-	return json.NewEncoder(w).Encode(response)
+// DeleteVertexAttributesParams defines parameters for DeleteVertexAttributes.
+type DeleteVertexAttributesParams struct {
+	// Description Descrição dos atributos que devem ser removidos
+	Description *[]string `form:"description,omitempty" json:"description,omitempty"`
 }
 
-type GetVertex422JSONResponse struct{ InvalidRequestJSONResponse }
+// GetVertexDependenciesParams defines parameters for GetVertexDependencies.
+type GetVertexDependenciesParams struct {
+	// All Se verdadeiro, retorna todas as dependências do recurso, mesmo que não estejam conectadas diretamente.
+	All *bool `form:"all,omitempty" json:"all,omitempty"`
 
-func (response GetVertex422JSONResponse) VisitGetVertexResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(422)
+	// EdgeClass Considera apenas relacionamentos destas classes. Recursos alcançados somente por outras classes são omitidos.
+	EdgeClass *EdgeClass `form:"edge_class,omitempty" json:"edge_class,omitempty"`
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Highlight Regras de destaque, que substituem o padrão da operação. Um recurso é destacado quando atende a qualquer regra: unhealthy (não saudáveis, incluindo o recurso consultado), endpoints (recurso consultado e, em caminhos, o destino), class:<classe>, attribute:<descrição>=<valor> ou none. Padrões: dependências e dependentes destacam os não saudáveis; vizinhos destacam os vizinhos não saudáveis; caminhos destacam as pontas e os saltos não saudáveis.
+	Highlight *Highlight `form:"highlight,omitempty" json:"highlight,omitempty"`
 
-type GetVertex500JSONResponse struct {
-	InternalServerErrorJSONResponse
-}
+	// Depth Número máximo de saltos a partir do recurso consultado. Quando informado, substitui o parâmetro all.
+	Depth *Depth `form:"depth,omitempty" json:"depth,omitempty"`
 
-func (response GetVertex500JSONResponse) VisitGetVertexResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	// IncludeClass Retorna apenas recursos destas classes. A busca continua passando pelos recursos de outras classes.
+	IncludeClass *IncludeClass `form:"include_class,omitempty" json:"include_class,omitempty"`
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ExcludeClass Omite recursos destas classes. A busca continua passando por eles.
+	ExcludeClass *ExcludeClass `form:"exclude_class,omitempty" json:"exclude_class,omitempty"`
 
-type GetVertexAttributesRequestObject struct {
-	Key Key `json:"key"`
+	// StopAtClass Inclui recursos destas classes, mas não continua a busca a partir deles
+	StopAtClass *StopAtClass `form:"stop_at_class,omitempty" json:"stop_at_class,omitempty"`
 }
 
-type GetVertexAttributesResponseObject interface {
-	VisitGetVertexAttributesResponse(w http.ResponseWriter) error
-}
+// GetVertexDependentsParams defines parameters for GetVertexDependents.
+type GetVertexDependentsParams struct {
+	// All Se verdadeiro, retorna todos os dependentes do recurso, mesmo que não estejam conectados diretamente.
+	All *bool `form:"all,omitempty" json:"all,omitempty"`
 
-type GetVertexAttributes200JSONResponse VertexAttrubutes
+	// EdgeClass Considera apenas relacionamentos destas classes. Recursos alcançados somente por outras classes são omitidos.
+	EdgeClass *EdgeClass `form:"edge_class,omitempty" json:"edge_class,omitempty"`
 
-func (response GetVertexAttributes200JSONResponse) VisitGetVertexAttributesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// Highlight Regras de destaque, que substituem o padrão da operação. Um recurso é destacado quando atende a qualquer regra: unhealthy (não saudáveis, incluindo o recurso consultado), endpoints (recurso consultado e, em caminhos, o destino), class:<classe>, attribute:<descrição>=<valor> ou none. Padrões: dependências e dependentes destacam os não saudáveis; vizinhos destacam os vizinhos não saudáveis; caminhos destacam as pontas e os saltos não saudáveis.
+	Highlight *Highlight `form:"highlight,omitempty" json:"highlight,omitempty"`
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Depth Número máximo de saltos a partir do recurso consultado. Quando informado, substitui o parâmetro all.
+	Depth *Depth `form:"depth,omitempty" json:"depth,omitempty"`
 
-type GetVertexAttributes401JSONResponse struct{ UnauthorizedJSONResponse }
+	// IncludeClass Retorna apenas recursos destas classes. A busca continua passando pelos recursos de outras classes.
+	IncludeClass *IncludeClass `form:"include_class,omitempty" json:"include_class,omitempty"`
 
-func (response GetVertexAttributes401JSONResponse) VisitGetVertexAttributesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	// ExcludeClass Omite recursos destas classes. A busca continua passando por eles.
+	ExcludeClass *ExcludeClass `form:"exclude_class,omitempty" json:"exclude_class,omitempty"`
 
-	return json.NewEncoder(w).Encode(response)
+	// StopAtClass Inclui recursos destas classes, mas não continua a busca a partir deles
+	StopAtClass *StopAtClass `form:"stop_at_class,omitempty" json:"stop_at_class,omitempty"`
 }
 
-type GetVertexAttributes404JSONResponse struct{ NotFoundJSONResponse }
-
-func (response GetVertexAttributes404JSONResponse) VisitGetVertexAttributesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+// MarkVertexUnhealthyTextBody defines parameters for MarkVertexUnhealthy.
+type MarkVertexUnhealthyTextBody = string
 
-	return json.NewEncoder(w).Encode(response)
+// MarkVertexUnhealthyParams defines parameters for MarkVertexUnhealthy.
+type MarkVertexUnhealthyParams struct {
+	// Reason Motivo da mudança, guardado no histórico de saúde
+	Reason *string `form:"reason,omitempty" json:"reason,omitempty"`
 }
 
-type GetVertexAttributes422JSONResponse struct{ InvalidRequestJSONResponse }
+// MarkVertexHealthyParams defines parameters for MarkVertexHealthy.
+type MarkVertexHealthyParams struct {
+	// Reason Motivo da mudança, guardado no histórico de saúde
+	Reason *string `form:"reason,omitempty" json:"reason,omitempty"`
+}
 
-func (response GetVertexAttributes422JSONResponse) VisitGetVertexAttributesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(422)
+// GetVertexHistoryParams defines parameters for GetVertexHistory.
+type GetVertexHistoryParams struct {
+	// From Inclui transições a partir deste momento
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
 
-	return json.NewEncoder(w).Encode(response)
+	// To Inclui transições até este momento
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
 }
 
-type GetVertexAttributes500JSONResponse struct {
-	InternalServerErrorJSONResponse
+// GetVertexImpactParams defines parameters for GetVertexImpact.
+type GetVertexImpactParams struct {
+	// CriticalClass Classes destacadas como críticas. Padrão: business_service
+	CriticalClass *[]string `form:"critical_class,omitempty" json:"critical_class,omitempty"`
 }
 
-func (response GetVertexAttributes500JSONResponse) VisitGetVertexAttributesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
-
-	return json.NewEncoder(w).Encode(response)
-}
+// GetVertexNeighborsParams defines parameters for GetVertexNeighbors.
+type GetVertexNeighborsParams struct {
+	// Highlight Regras de destaque, que substituem o padrão da operação. Um recurso é destacado quando atende a qualquer regra: unhealthy (não saudáveis, incluindo o recurso consultado), endpoints (recurso consultado e, em caminhos, o destino), class:<classe>, attribute:<descrição>=<valor> ou none. Padrões: dependências e dependentes destacam os não saudáveis; vizinhos destacam os vizinhos não saudáveis; caminhos destacam as pontas e os saltos não saudáveis.
+	Highlight *Highlight `form:"highlight,omitempty" json:"highlight,omitempty"`
 
-type GetVertexDependenciesRequestObject struct {
-	Key    Key `json:"key"`
-	Params GetVertexDependenciesParams
-}
+	// Depth Número máximo de saltos a partir do recurso consultado. Quando informado, substitui o parâmetro all.
+	Depth *Depth `form:"depth,omitempty" json:"depth,omitempty"`
 
-type GetVertexDependenciesResponseObject interface {
-	VisitGetVertexDependenciesResponse(w http.ResponseWriter) error
-}
+	// IncludeClass Retorna apenas recursos destas classes. A busca continua passando pelos recursos de outras classes.
+	IncludeClass *IncludeClass `form:"include_class,omitempty" json:"include_class,omitempty"`
 
-type GetVertexDependencies200JSONResponse Subgraph
+	// ExcludeClass Omite recursos destas classes. A busca continua passando por eles.
+	ExcludeClass *ExcludeClass `form:"exclude_class,omitempty" json:"exclude_class,omitempty"`
 
-func (response GetVertexDependencies200JSONResponse) VisitGetVertexDependenciesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// StopAtClass Inclui recursos destas classes, mas não continua a busca a partir deles
+	StopAtClass *StopAtClass `form:"stop_at_class,omitempty" json:"stop_at_class,omitempty"`
+}
 
-	return json.NewEncoder(w).Encode(response)
+// GetPathParams defines parameters for GetPath.
+type GetPathParams struct {
+	// Highlight Regras de destaque, que substituem o padrão da operação. Um recurso é destacado quando atende a qualquer regra: unhealthy (não saudáveis, incluindo o recurso consultado), endpoints (recurso consultado e, em caminhos, o destino), class:<classe>, attribute:<descrição>=<valor> ou none. Padrões: dependências e dependentes destacam os não saudáveis; vizinhos destacam os vizinhos não saudáveis; caminhos destacam as pontas e os saltos não saudáveis.
+	Highlight *Highlight `form:"highlight,omitempty" json:"highlight,omitempty"`
 }
 
-type GetVertexDependencies401JSONResponse struct{ UnauthorizedJSONResponse }
+// GetPathsParams defines parameters for GetPaths.
+type GetPathsParams struct {
+	// Mode all-shortest (padrão) ou k-shortest
+	Mode *string `form:"mode,omitempty" json:"mode,omitempty"`
 
-func (response GetVertexDependencies401JSONResponse) VisitGetVertexDependenciesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	// K Quantidade de caminhos no modo k-shortest, entre 1 e 50
+	K *int `form:"k,omitempty" json:"k,omitempty"`
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// AvoidUnhealthy Evita recursos intermediários não saudáveis
+	AvoidUnhealthy *bool `form:"avoid_unhealthy,omitempty" json:"avoid_unhealthy,omitempty"`
 
-type GetVertexDependencies404JSONResponse struct{ NotFoundJSONResponse }
+	// AvoidClass Evita recursos intermediários destas classes
+	AvoidClass *[]string `form:"avoid_class,omitempty" json:"avoid_class,omitempty"`
+}
 
-func (response GetVertexDependencies404JSONResponse) VisitGetVertexDependenciesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+// GetVertexStartupOrderParams defines parameters for GetVertexStartupOrder.
+type GetVertexStartupOrderParams struct {
+	// EdgeClass Considera apenas relacionamentos destas classes. Recursos alcançados somente por outras classes são omitidos.
+	EdgeClass *EdgeClass `form:"edge_class,omitempty" json:"edge_class,omitempty"`
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Depth Número máximo de saltos a partir do recurso consultado. Quando informado, substitui o parâmetro all.
+	Depth *Depth `form:"depth,omitempty" json:"depth,omitempty"`
 
-type GetVertexDependencies422JSONResponse struct{ InvalidRequestJSONResponse }
+	// IncludeClass Retorna apenas recursos destas classes. A busca continua passando pelos recursos de outras classes.
+	IncludeClass *IncludeClass `form:"include_class,omitempty" json:"include_class,omitempty"`
 
-func (response GetVertexDependencies422JSONResponse) VisitGetVertexDependenciesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(422)
+	// ExcludeClass Omite recursos destas classes. A busca continua passando por eles.
+	ExcludeClass *ExcludeClass `form:"exclude_class,omitempty" json:"exclude_class,omitempty"`
 
-	return json.NewEncoder(w).Encode(response)
+	// StopAtClass Inclui recursos destas classes, mas não continua a busca a partir deles
+	StopAtClass *StopAtClass `form:"stop_at_class,omitempty" json:"stop_at_class,omitempty"`
 }
 
-type GetVertexDependencies500JSONResponse struct {
-	InternalServerErrorJSONResponse
-}
+// CreateEdgeJSONRequestBody defines body for CreateEdge for application/json ContentType.
+type CreateEdgeJSONRequestBody = NewEdge
 
-func (response GetVertexDependencies500JSONResponse) VisitGetVertexDependenciesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+// UpdateEdgeJSONRequestBody defines body for UpdateEdge for application/json ContentType.
+type UpdateEdgeJSONRequestBody = EdgeUpdate
 
-	return json.NewEncoder(w).Encode(response)
-}
+// ImportGraphJSONRequestBody defines body for ImportGraph for application/json ContentType.
+type ImportGraphJSONRequestBody = Subgraph
 
-type GetVertexDependentsRequestObject struct {
-	Key    Key `json:"key"`
-	Params GetVertexDependentsParams
-}
+// ImportGraphMultipartRequestBody defines body for ImportGraph for multipart/form-data ContentType.
+type ImportGraphMultipartRequestBody ImportGraphMultipartBody
 
-type GetVertexDependentsResponseObject interface {
-	VisitGetVertexDependentsResponse(w http.ResponseWriter) error
-}
+// SetClassPropagationJSONRequestBody defines body for SetClassPropagation for application/json ContentType.
+type SetClassPropagationJSONRequestBody = PropagationPolicy
 
-type GetVertexDependents200JSONResponse Subgraph
+// SetRedundancyGroupJSONRequestBody defines body for SetRedundancyGroup for application/json ContentType.
+type SetRedundancyGroupJSONRequestBody = NewRedundancyGroup
 
-func (response GetVertexDependents200JSONResponse) VisitGetVertexDependentsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// SimulateJSONRequestBody defines body for Simulate for application/json ContentType.
+type SimulateJSONRequestBody = SimulationRequest
 
-	return json.NewEncoder(w).Encode(response)
-}
+// CreateVertexJSONRequestBody defines body for CreateVertex for application/json ContentType.
+type CreateVertexJSONRequestBody = NewVertex
 
-type GetVertexDependents401JSONResponse struct{ UnauthorizedJSONResponse }
+// UpdateVertexJSONRequestBody defines body for UpdateVertex for application/json ContentType.
+type UpdateVertexJSONRequestBody = VertexUpdate
 
-func (response GetVertexDependents401JSONResponse) VisitGetVertexDependentsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+// UpdateVertexAttributesJSONRequestBody defines body for UpdateVertexAttributes for application/json ContentType.
+type UpdateVertexAttributesJSONRequestBody = VertexAttrubutes
 
-	return json.NewEncoder(w).Encode(response)
-}
+// ReplaceVertexAttributesJSONRequestBody defines body for ReplaceVertexAttributes for application/json ContentType.
+type ReplaceVertexAttributesJSONRequestBody = VertexAttrubutes
 
-type GetVertexDependents404JSONResponse struct{ NotFoundJSONResponse }
+// MarkVertexUnhealthyJSONRequestBody defines body for MarkVertexUnhealthy for application/json ContentType.
+type MarkVertexUnhealthyJSONRequestBody = HealthReport
 
-func (response GetVertexDependents404JSONResponse) VisitGetVertexDependentsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+// MarkVertexUnhealthyTextRequestBody defines body for MarkVertexUnhealthy for text/plain ContentType.
+type MarkVertexUnhealthyTextRequestBody = MarkVertexUnhealthyTextBody
 
-	return json.NewEncoder(w).Encode(response)
-}
+// SetVertexPropagationJSONRequestBody defines body for SetVertexPropagation for application/json ContentType.
+type SetVertexPropagationJSONRequestBody = PropagationPolicy
 
-type GetVertexDependents422JSONResponse struct{ InvalidRequestJSONResponse }
+// CreateWebhookJSONRequestBody defines body for CreateWebhook for application/json ContentType.
+type CreateWebhookJSONRequestBody = NewWebhook
 
-func (response GetVertexDependents422JSONResponse) VisitGetVertexDependentsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(422)
+// UpdateWebhookJSONRequestBody defines body for UpdateWebhook for application/json ContentType.
+type UpdateWebhookJSONRequestBody = NewWebhook
 
-	return json.NewEncoder(w).Encode(response)
+// AsVertexAttrubutesValue0 returns the union data inside the VertexAttrubutes_Value as a VertexAttrubutesValue0
+func (t VertexAttrubutes_Value) AsVertexAttrubutesValue0() (VertexAttrubutesValue0, error) {
+	var body VertexAttrubutesValue0
+	err := json.Unmarshal(t.union, &body)
+	return body, err
 }
 
-type GetVertexDependents500JSONResponse struct {
-	InternalServerErrorJSONResponse
+// FromVertexAttrubutesValue0 overwrites any union data inside the VertexAttrubutes_Value as the provided VertexAttrubutesValue0
+func (t *VertexAttrubutes_Value) FromVertexAttrubutesValue0(v VertexAttrubutesValue0) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
 }
 
-func (response GetVertexDependents500JSONResponse) VisitGetVertexDependentsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+// MergeVertexAttrubutesValue0 performs a merge with any union data inside the VertexAttrubutes_Value, using the provided VertexAttrubutesValue0
+func (t *VertexAttrubutes_Value) MergeVertexAttrubutesValue0(v VertexAttrubutesValue0) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
 }
 
-type MarkVertexUnhealthyRequestObject struct {
-	Key Key `json:"key"`
+// AsVertexAttrubutesValue1 returns the union data inside the VertexAttrubutes_Value as a VertexAttrubutesValue1
+func (t VertexAttrubutes_Value) AsVertexAttrubutesValue1() (VertexAttrubutesValue1, error) {
+	var body VertexAttrubutesValue1
+	err := json.Unmarshal(t.union, &body)
+	return body, err
 }
 
-type MarkVertexUnhealthyResponseObject interface {
-	VisitMarkVertexUnhealthyResponse(w http.ResponseWriter) error
+// FromVertexAttrubutesValue1 overwrites any union data inside the VertexAttrubutes_Value as the provided VertexAttrubutesValue1
+func (t *VertexAttrubutes_Value) FromVertexAttrubutesValue1(v VertexAttrubutesValue1) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
 }
 
-type MarkVertexUnhealthy200Response struct {
-}
+// MergeVertexAttrubutesValue1 performs a merge with any union data inside the VertexAttrubutes_Value, using the provided VertexAttrubutesValue1
+func (t *VertexAttrubutes_Value) MergeVertexAttrubutesValue1(v VertexAttrubutesValue1) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
 
-func (response MarkVertexUnhealthy200Response) VisitMarkVertexUnhealthyResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
 }
 
-type MarkVertexUnhealthy401JSONResponse struct{ UnauthorizedJSONResponse }
-
-func (response MarkVertexUnhealthy401JSONResponse) VisitMarkVertexUnhealthyResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
-
-	return json.NewEncoder(w).Encode(response)
+// AsVertexAttrubutesValue2 returns the union data inside the VertexAttrubutes_Value as a VertexAttrubutesValue2
+func (t VertexAttrubutes_Value) AsVertexAttrubutesValue2() (VertexAttrubutesValue2, error) {
+	var body VertexAttrubutesValue2
+	err := json.Unmarshal(t.union, &body)
+	return body, err
 }
 
-type MarkVertexUnhealthy404JSONResponse struct{ NotFoundJSONResponse }
-
-func (response MarkVertexUnhealthy404JSONResponse) VisitMarkVertexUnhealthyResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
-
-	return json.NewEncoder(w).Encode(response)
+// FromVertexAttrubutesValue2 overwrites any union data inside the VertexAttrubutes_Value as the provided VertexAttrubutesValue2
+func (t *VertexAttrubutes_Value) FromVertexAttrubutesValue2(v VertexAttrubutesValue2) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
 }
 
-type MarkVertexUnhealthy422JSONResponse struct{ InvalidRequestJSONResponse }
-
-func (response MarkVertexUnhealthy422JSONResponse) VisitMarkVertexUnhealthyResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(422)
+// MergeVertexAttrubutesValue2 performs a merge with any union data inside the VertexAttrubutes_Value, using the provided VertexAttrubutesValue2
+func (t *VertexAttrubutes_Value) MergeVertexAttrubutesValue2(v VertexAttrubutesValue2) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
 }
 
-type MarkVertexUnhealthy500JSONResponse struct {
-	InternalServerErrorJSONResponse
+func (t VertexAttrubutes_Value) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
 }
 
-func (response MarkVertexUnhealthy500JSONResponse) VisitMarkVertexUnhealthyResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
-
-	return json.NewEncoder(w).Encode(response)
+func (t *VertexAttrubutes_Value) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Recursos críticos
+	// (GET /analysis/critical)
+	GetCriticalVertices(w http.ResponseWriter, r *http.Request, params GetCriticalVerticesParams)
+	// Ciclos de dependência
+	// (GET /analysis/cycles)
+	GetCycles(w http.ResponseWriter, r *http.Request)
+	// Recursos órfãos
+	// (GET /analysis/orphans)
+	GetOrphans(w http.ResponseWriter, r *http.Request)
+	// Pontos únicos de falha
+	// (GET /analysis/spof)
+	GetSinglePointsOfFailure(w http.ResponseWriter, r *http.Request)
+	// Relacionamentos
+	// (GET /edges)
+	ListEdges(w http.ResponseWriter, r *http.Request)
+	// Criar relacionamento
+	// (POST /edges)
+	CreateEdge(w http.ResponseWriter, r *http.Request)
+	// Remover relacionamento
+	// (DELETE /edges/{key})
+	DeleteEdge(w http.ResponseWriter, r *http.Request, key EdgeKey)
+	// Detalhes de um relacionamento
+	// (GET /edges/{key})
+	GetEdge(w http.ResponseWriter, r *http.Request, key EdgeKey)
+	// Atualizar relacionamento
+	// (PUT /edges/{key})
+	UpdateEdge(w http.ResponseWriter, r *http.Request, key EdgeKey)
+	// Acompanhar eventos
+	// (GET /events)
+	GetEvents(w http.ResponseWriter, r *http.Request, params GetEventsParams)
+	// Exportar grafo
+	// (GET /export)
+	ExportGraph(w http.ResponseWriter, r *http.Request, params ExportGraphParams)
+	// Importar grafo
+	// (POST /import)
+	ImportGraph(w http.ResponseWriter, r *http.Request, params ImportGraphParams)
+	// Políticas de propagação por classe
+	// (GET /propagation/classes)
+	ListClassPropagation(w http.ResponseWriter, r *http.Request)
+	// Remover política de propagação da classe
+	// (DELETE /propagation/classes/{class})
+	DeleteClassPropagation(w http.ResponseWriter, r *http.Request, class string)
+	// Definir política de propagação da classe
+	// (PUT /propagation/classes/{class})
+	SetClassPropagation(w http.ResponseWriter, r *http.Request, class string)
+	// Grupos de redundância
+	// (GET /redundancy-groups)
+	ListRedundancyGroups(w http.ResponseWriter, r *http.Request)
+	// Remover grupo de redundância
+	// (DELETE /redundancy-groups/{name})
+	DeleteRedundancyGroup(w http.ResponseWriter, r *http.Request, name string)
+	// Grupo de redundância
+	// (GET /redundancy-groups/{name})
+	GetRedundancyGroup(w http.ResponseWriter, r *http.Request, name string)
+	// Definir grupo de redundância
+	// (PUT /redundancy-groups/{name})
+	SetRedundancyGroup(w http.ResponseWriter, r *http.Request, name string)
+	// Simular falhas
+	// (POST /simulate)
+	Simulate(w http.ResponseWriter, r *http.Request)
+	// Ordem de inicialização do grafo
+	// (GET /startup-order)
+	GetStartupOrder(w http.ResponseWriter, r *http.Request)
+	// Resumo da infraestrutura
+	// (GET /summary)
+	Summary(w http.ResponseWriter, r *http.Request, params SummaryParams)
+	// Listar recursos
+	// (GET /vertices)
+	ListVertices(w http.ResponseWriter, r *http.Request, params ListVerticesParams)
+	// Criar recurso
+	// (POST /vertices)
+	CreateVertex(w http.ResponseWriter, r *http.Request)
+	// Limpar status de saúde
+	// (POST /vertices/clear-health-status)
+	ClearHealthStatus(w http.ResponseWriter, r *http.Request)
+	// Remover recurso
+	// (DELETE /vertices/{key})
+	DeleteVertex(w http.ResponseWriter, r *http.Request, key Key)
+	// Detalhes de um recurso
+	// (GET /vertices/{key})
+	GetVertex(w http.ResponseWriter, r *http.Request, key Key)
+	// Atualizar recurso
+	// (PUT /vertices/{key})
+	UpdateVertex(w http.ResponseWriter, r *http.Request, key Key)
+	// Remover atributos de um recurso
+	// (DELETE /vertices/{key}/attributes)
+	DeleteVertexAttributes(w http.ResponseWriter, r *http.Request, key Key, params DeleteVertexAttributesParams)
+	// Atributos de um recurso
+	// (GET /vertices/{key}/attributes)
+	GetVertexAttributes(w http.ResponseWriter, r *http.Request, key Key)
+	// Atualizar atributos de um recurso
+	// (PATCH /vertices/{key}/attributes)
+	UpdateVertexAttributes(w http.ResponseWriter, r *http.Request, key Key)
+	// Substituir atributos de um recurso
+	// (PUT /vertices/{key}/attributes)
+	ReplaceVertexAttributes(w http.ResponseWriter, r *http.Request, key Key)
+	// Dependencias de um recurso
+	// (GET /vertices/{key}/dependencies)
+	GetVertexDependencies(w http.ResponseWriter, r *http.Request, key Key, params GetVertexDependenciesParams)
+	// Recursos dependentes
+	// (GET /vertices/{key}/dependents)
+	GetVertexDependents(w http.ResponseWriter, r *http.Request, key Key, params GetVertexDependentsParams)
+	// Marcar recurso como não saudável
+	// (DELETE /vertices/{key}/healthy)
+	MarkVertexUnhealthy(w http.ResponseWriter, r *http.Request, key Key, params MarkVertexUnhealthyParams)
+	// Marcar recurso como saudável
+	// (POST /vertices/{key}/healthy)
+	MarkVertexHealthy(w http.ResponseWriter, r *http.Request, key Key, params MarkVertexHealthyParams)
+	// Histórico de saúde
+	// (GET /vertices/{key}/history)
+	GetVertexHistory(w http.ResponseWriter, r *http.Request, key Key, params GetVertexHistoryParams)
+	// Impacto
+	// (GET /vertices/{key}/impact)
+	GetVertexImpact(w http.ResponseWriter, r *http.Request, key Key, params GetVertexImpactParams)
+	// Vizinhos
+	// (GET /vertices/{key}/neighbors)
+	GetVertexNeighbors(w http.ResponseWriter, r *http.Request, key Key, params GetVertexNeighborsParams)
+	// Caminho entre dois recursos
+	// (GET /vertices/{key}/path/{target})
+	GetPath(w http.ResponseWriter, r *http.Request, key Key, target string, params GetPathParams)
+	// Caminhos alternativos entre dois recursos
+	// (GET /vertices/{key}/paths/{target})
+	GetPaths(w http.ResponseWriter, r *http.Request, key Key, target string, params GetPathsParams)
+	// Remover política de propagação do recurso
+	// (DELETE /vertices/{key}/propagation)
+	DeleteVertexPropagation(w http.ResponseWriter, r *http.Request, key Key)
+	// Política de propagação do recurso
+	// (GET /vertices/{key}/propagation)
+	GetVertexPropagation(w http.ResponseWriter, r *http.Request, key Key)
+	// Definir política de propagação do recurso
+	// (PUT /vertices/{key}/propagation)
+	SetVertexPropagation(w http.ResponseWriter, r *http.Request, key Key)
+	// Causa raiz
+	// (GET /vertices/{key}/root-cause)
+	GetVertexRootCause(w http.ResponseWriter, r *http.Request, key Key)
+	// Ordem de inicialização de um recurso
+	// (GET /vertices/{key}/startup-order)
+	GetVertexStartupOrder(w http.ResponseWriter, r *http.Request, key Key, params GetVertexStartupOrderParams)
+	// Listar webhooks
+	// (GET /webhooks)
+	ListWebhooks(w http.ResponseWriter, r *http.Request)
+	// Criar webhook
+	// (POST /webhooks)
+	CreateWebhook(w http.ResponseWriter, r *http.Request)
+	// Remover webhook
+	// (DELETE /webhooks/{id})
+	DeleteWebhook(w http.ResponseWriter, r *http.Request, id WebhookId)
+	// Obter webhook
+	// (GET /webhooks/{id})
+	GetWebhook(w http.ResponseWriter, r *http.Request, id WebhookId)
+	// Substituir webhook
+	// (PUT /webhooks/{id})
+	UpdateWebhook(w http.ResponseWriter, r *http.Request, id WebhookId)
+	// Listar entregas
+	// (GET /webhooks/{id}/deliveries)
+	ListWebhookDeliveries(w http.ResponseWriter, r *http.Request, id WebhookId)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// GetCriticalVertices operation middleware
+func (siw *ServerInterfaceWrapper) GetCriticalVertices(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerHttpAuthenticationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCriticalVerticesParams
+
+	// ------------- Optional query parameter "metric" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "metric", r.URL.Query(), &params.Metric)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "metric", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCriticalVertices(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetCycles operation middleware
+func (siw *ServerInterfaceWrapper) GetCycles(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerHttpAuthenticationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCycles(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetOrphans operation middleware
+func (siw *ServerInterfaceWrapper) GetOrphans(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerHttpAuthenticationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetOrphans(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetSinglePointsOfFailure operation middleware
+func (siw *ServerInterfaceWrapper) GetSinglePointsOfFailure(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerHttpAuthenticationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSinglePointsOfFailure(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListEdges operation middleware
+func (siw *ServerInterfaceWrapper) ListEdges(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerHttpAuthenticationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListEdges(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateEdge operation middleware
+func (siw *ServerInterfaceWrapper) CreateEdge(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerHttpAuthenticationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateEdge(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteEdge operation middleware
+func (siw *ServerInterfaceWrapper) DeleteEdge(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "key" -------------
+	var key EdgeKey
+
+	err = runtime.BindStyledParameterWithOptions("simple", "key", r.PathValue("key"), &key, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "key", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerHttpAuthenticationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteEdge(w, r, key)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetEdge operation middleware
+func (siw *ServerInterfaceWrapper) GetEdge(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "key" -------------
+	var key EdgeKey
+
+	err = runtime.BindStyledParameterWithOptions("simple", "key", r.PathValue("key"), &key, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "key", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerHttpAuthenticationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetEdge(w, r, key)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateEdge operation middleware
+func (siw *ServerInterfaceWrapper) UpdateEdge(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "key" -------------
+	var key EdgeKey
+
+	err = runtime.BindStyledParameterWithOptions("simple", "key", r.PathValue("key"), &key, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "key", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerHttpAuthenticationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateEdge(w, r, key)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetEvents operation middleware
+func (siw *ServerInterfaceWrapper) GetEvents(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerHttpAuthenticationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetEventsParams
+
+	// ------------- Optional query parameter "key" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "key", r.URL.Query(), &params.Key)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "key", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "class" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "class", r.URL.Query(), &params.Class)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "class", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "dependents_of" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dependents_of", r.URL.Query(), &params.DependentsOf)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dependents_of", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Last-Event-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Last-Event-ID")]; found {
+		var LastEventID string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Last-Event-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Last-Event-ID", valueList[0], &LastEventID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Last-Event-ID", Err: err})
+			return
+		}
+
+		params.LastEventID = &LastEventID
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetEvents(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ExportGraph operation middleware
+func (siw *ServerInterfaceWrapper) ExportGraph(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerHttpAuthenticationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ExportGraphParams
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "scope" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "scope", r.URL.Query(), &params.Scope)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "scope", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "key" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "key", r.URL.Query(), &params.Key)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "key", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "target" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "target", r.URL.Query(), &params.Target)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "target", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "all" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "all", r.URL.Query(), &params.All)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "all", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Accept" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Accept")]; found {
+		var Accept string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Accept", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Accept", valueList[0], &Accept, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Accept", Err: err})
+			return
+		}
+
+		params.Accept = &Accept
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ExportGraph(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ImportGraph operation middleware
+func (siw *ServerInterfaceWrapper) ImportGraph(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerHttpAuthenticationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ImportGraphParams
+
+	// ------------- Optional query parameter "mode" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "mode", r.URL.Query(), &params.Mode)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "mode", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ImportGraph(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListClassPropagation operation middleware
+func (siw *ServerInterfaceWrapper) ListClassPropagation(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerHttpAuthenticationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListClassPropagation(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteClassPropagation operation middleware
+func (siw *ServerInterfaceWrapper) DeleteClassPropagation(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "class" -------------
+	var class string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "class", r.PathValue("class"), &class, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "class", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerHttpAuthenticationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteClassPropagation(w, r, class)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetClassPropagation operation middleware
+func (siw *ServerInterfaceWrapper) SetClassPropagation(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "class" -------------
+	var class string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "class", r.PathValue("class"), &class, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "class", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerHttpAuthenticationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetClassPropagation(w, r, class)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListRedundancyGroups operation middleware
+func (siw *ServerInterfaceWrapper) ListRedundancyGroups(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerHttpAuthenticationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListRedundancyGroups(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteRedundancyGroup operation middleware
+func (siw *ServerInterfaceWrapper) DeleteRedundancyGroup(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerHttpAuthenticationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteRedundancyGroup(w, r, name)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetRedundancyGroup operation middleware
+func (siw *ServerInterfaceWrapper) GetRedundancyGroup(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerHttpAuthenticationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRedundancyGroup(w, r, name)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetRedundancyGroup operation middleware
+func (siw *ServerInterfaceWrapper) SetRedundancyGroup(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerHttpAuthenticationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetRedundancyGroup(w, r, name)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// Simulate operation middleware
+func (siw *ServerInterfaceWrapper) Simulate(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerHttpAuthenticationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.Simulate(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetStartupOrder operation middleware
+func (siw *ServerInterfaceWrapper) GetStartupOrder(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerHttpAuthenticationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStartupOrder(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// Summary operation middleware
+func (siw *ServerInterfaceWrapper) Summary(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerHttpAuthenticationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SummaryParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "stale_after" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "stale_after", r.URL.Query(), &params.StaleAfter)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "stale_after", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "top" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "top", r.URL.Query(), &params.Top)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "top", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "origin" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "origin", r.URL.Query(), &params.Origin)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "origin", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.Summary(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListVertices operation middleware
+func (siw *ServerInterfaceWrapper) ListVertices(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerHttpAuthenticationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListVerticesParams
+
+	// ------------- Optional query parameter "class" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "class", r.URL.Query(), &params.Class)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "class", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "healthy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "healthy", r.URL.Query(), &params.Healthy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "healthy", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "label" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "label", r.URL.Query(), &params.Label)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "label", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "attribute" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "attribute", r.URL.Query(), &params.Attribute)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "attribute", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "origin" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "origin", r.URL.Query(), &params.Origin)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "origin", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListVertices(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateVertex operation middleware
+func (siw *ServerInterfaceWrapper) CreateVertex(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerHttpAuthenticationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateVertex(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ClearHealthStatus operation middleware
+func (siw *ServerInterfaceWrapper) ClearHealthStatus(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerHttpAuthenticationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ClearHealthStatus(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteVertex operation middleware
+func (siw *ServerInterfaceWrapper) DeleteVertex(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "key" -------------
+	var key Key
+
+	err = runtime.BindStyledParameterWithOptions("simple", "key", r.PathValue("key"), &key, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "key", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerHttpAuthenticationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteVertex(w, r, key)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetVertex operation middleware
+func (siw *ServerInterfaceWrapper) GetVertex(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "key" -------------
+	var key Key
+
+	err = runtime.BindStyledParameterWithOptions("simple", "key", r.PathValue("key"), &key, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "key", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerHttpAuthenticationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetVertex(w, r, key)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateVertex operation middleware
+func (siw *ServerInterfaceWrapper) UpdateVertex(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "key" -------------
+	var key Key
+
+	err = runtime.BindStyledParameterWithOptions("simple", "key", r.PathValue("key"), &key, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "key", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerHttpAuthenticationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateVertex(w, r, key)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteVertexAttributes operation middleware
+func (siw *ServerInterfaceWrapper) DeleteVertexAttributes(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "key" -------------
+	var key Key
+
+	err = runtime.BindStyledParameterWithOptions("simple", "key", r.PathValue("key"), &key, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "key", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerHttpAuthenticationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteVertexAttributesParams
+
+	// ------------- Optional query parameter "description" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "description", r.URL.Query(), &params.Description)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "description", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteVertexAttributes(w, r, key, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetVertexAttributes operation middleware
+func (siw *ServerInterfaceWrapper) GetVertexAttributes(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "key" -------------
+	var key Key
+
+	err = runtime.BindStyledParameterWithOptions("simple", "key", r.PathValue("key"), &key, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "key", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerHttpAuthenticationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetVertexAttributes(w, r, key)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateVertexAttributes operation middleware
+func (siw *ServerInterfaceWrapper) UpdateVertexAttributes(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "key" -------------
+	var key Key
+
+	err = runtime.BindStyledParameterWithOptions("simple", "key", r.PathValue("key"), &key, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "key", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerHttpAuthenticationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateVertexAttributes(w, r, key)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ReplaceVertexAttributes operation middleware
+func (siw *ServerInterfaceWrapper) ReplaceVertexAttributes(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "key" -------------
+	var key Key
+
+	err = runtime.BindStyledParameterWithOptions("simple", "key", r.PathValue("key"), &key, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "key", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerHttpAuthenticationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReplaceVertexAttributes(w, r, key)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetVertexDependencies operation middleware
+func (siw *ServerInterfaceWrapper) GetVertexDependencies(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "key" -------------
+	var key Key
+
+	err = runtime.BindStyledParameterWithOptions("simple", "key", r.PathValue("key"), &key, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "key", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerHttpAuthenticationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetVertexDependenciesParams
+
+	// ------------- Optional query parameter "all" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "all", r.URL.Query(), &params.All)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "all", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "edge_class" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "edge_class", r.URL.Query(), &params.EdgeClass)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "edge_class", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "highlight" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "highlight", r.URL.Query(), &params.Highlight)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "highlight", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "depth" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "depth", r.URL.Query(), &params.Depth)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "depth", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "include_class" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_class", r.URL.Query(), &params.IncludeClass)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "include_class", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "exclude_class" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "exclude_class", r.URL.Query(), &params.ExcludeClass)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "exclude_class", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "stop_at_class" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "stop_at_class", r.URL.Query(), &params.StopAtClass)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "stop_at_class", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetVertexDependencies(w, r, key, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetVertexDependents operation middleware
+func (siw *ServerInterfaceWrapper) GetVertexDependents(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "key" -------------
+	var key Key
+
+	err = runtime.BindStyledParameterWithOptions("simple", "key", r.PathValue("key"), &key, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "key", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerHttpAuthenticationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetVertexDependentsParams
+
+	// ------------- Optional query parameter "all" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "all", r.URL.Query(), &params.All)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "all", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "edge_class" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "edge_class", r.URL.Query(), &params.EdgeClass)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "edge_class", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "highlight" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "highlight", r.URL.Query(), &params.Highlight)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "highlight", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "depth" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "depth", r.URL.Query(), &params.Depth)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "depth", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "include_class" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_class", r.URL.Query(), &params.IncludeClass)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "include_class", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "exclude_class" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "exclude_class", r.URL.Query(), &params.ExcludeClass)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "exclude_class", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "stop_at_class" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "stop_at_class", r.URL.Query(), &params.StopAtClass)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "stop_at_class", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetVertexDependents(w, r, key, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// MarkVertexUnhealthy operation middleware
+func (siw *ServerInterfaceWrapper) MarkVertexUnhealthy(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "key" -------------
+	var key Key
+
+	err = runtime.BindStyledParameterWithOptions("simple", "key", r.PathValue("key"), &key, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "key", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerHttpAuthenticationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params MarkVertexUnhealthyParams
+
+	// ------------- Optional query parameter "reason" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "reason", r.URL.Query(), &params.Reason)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "reason", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.MarkVertexUnhealthy(w, r, key, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// MarkVertexHealthy operation middleware
+func (siw *ServerInterfaceWrapper) MarkVertexHealthy(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "key" -------------
+	var key Key
+
+	err = runtime.BindStyledParameterWithOptions("simple", "key", r.PathValue("key"), &key, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "key", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerHttpAuthenticationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params MarkVertexHealthyParams
+
+	// ------------- Optional query parameter "reason" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "reason", r.URL.Query(), &params.Reason)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "reason", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.MarkVertexHealthy(w, r, key, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetVertexHistory operation middleware
+func (siw *ServerInterfaceWrapper) GetVertexHistory(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "key" -------------
+	var key Key
+
+	err = runtime.BindStyledParameterWithOptions("simple", "key", r.PathValue("key"), &key, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "key", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerHttpAuthenticationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetVertexHistoryParams
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetVertexHistory(w, r, key, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetVertexImpact operation middleware
+func (siw *ServerInterfaceWrapper) GetVertexImpact(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "key" -------------
+	var key Key
+
+	err = runtime.BindStyledParameterWithOptions("simple", "key", r.PathValue("key"), &key, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "key", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerHttpAuthenticationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetVertexImpactParams
+
+	// ------------- Optional query parameter "critical_class" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "critical_class", r.URL.Query(), &params.CriticalClass)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "critical_class", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetVertexImpact(w, r, key, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetVertexNeighbors operation middleware
+func (siw *ServerInterfaceWrapper) GetVertexNeighbors(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "key" -------------
+	var key Key
+
+	err = runtime.BindStyledParameterWithOptions("simple", "key", r.PathValue("key"), &key, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "key", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerHttpAuthenticationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetVertexNeighborsParams
+
+	// ------------- Optional query parameter "highlight" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "highlight", r.URL.Query(), &params.Highlight)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "highlight", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "depth" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "depth", r.URL.Query(), &params.Depth)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "depth", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "include_class" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_class", r.URL.Query(), &params.IncludeClass)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "include_class", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "exclude_class" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "exclude_class", r.URL.Query(), &params.ExcludeClass)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "exclude_class", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "stop_at_class" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "stop_at_class", r.URL.Query(), &params.StopAtClass)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "stop_at_class", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetVertexNeighbors(w, r, key, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetPath operation middleware
+func (siw *ServerInterfaceWrapper) GetPath(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "key" -------------
+	var key Key
+
+	err = runtime.BindStyledParameterWithOptions("simple", "key", r.PathValue("key"), &key, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "key", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "target" -------------
+	var target string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "target", r.PathValue("target"), &target, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "target", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerHttpAuthenticationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPathParams
+
+	// ------------- Optional query parameter "highlight" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "highlight", r.URL.Query(), &params.Highlight)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "highlight", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPath(w, r, key, target, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetPaths operation middleware
+func (siw *ServerInterfaceWrapper) GetPaths(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "key" -------------
+	var key Key
+
+	err = runtime.BindStyledParameterWithOptions("simple", "key", r.PathValue("key"), &key, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "key", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "target" -------------
+	var target string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "target", r.PathValue("target"), &target, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "target", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerHttpAuthenticationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPathsParams
+
+	// ------------- Optional query parameter "mode" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "mode", r.URL.Query(), &params.Mode)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "mode", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "k" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "k", r.URL.Query(), &params.K)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "k", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "avoid_unhealthy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "avoid_unhealthy", r.URL.Query(), &params.AvoidUnhealthy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "avoid_unhealthy", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "avoid_class" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "avoid_class", r.URL.Query(), &params.AvoidClass)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "avoid_class", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPaths(w, r, key, target, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteVertexPropagation operation middleware
+func (siw *ServerInterfaceWrapper) DeleteVertexPropagation(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "key" -------------
+	var key Key
+
+	err = runtime.BindStyledParameterWithOptions("simple", "key", r.PathValue("key"), &key, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "key", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerHttpAuthenticationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteVertexPropagation(w, r, key)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetVertexPropagation operation middleware
+func (siw *ServerInterfaceWrapper) GetVertexPropagation(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "key" -------------
+	var key Key
+
+	err = runtime.BindStyledParameterWithOptions("simple", "key", r.PathValue("key"), &key, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "key", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerHttpAuthenticationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetVertexPropagation(w, r, key)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetVertexPropagation operation middleware
+func (siw *ServerInterfaceWrapper) SetVertexPropagation(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "key" -------------
+	var key Key
+
+	err = runtime.BindStyledParameterWithOptions("simple", "key", r.PathValue("key"), &key, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "key", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerHttpAuthenticationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetVertexPropagation(w, r, key)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetVertexRootCause operation middleware
+func (siw *ServerInterfaceWrapper) GetVertexRootCause(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "key" -------------
+	var key Key
+
+	err = runtime.BindStyledParameterWithOptions("simple", "key", r.PathValue("key"), &key, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "key", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerHttpAuthenticationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetVertexRootCause(w, r, key)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetVertexStartupOrder operation middleware
+func (siw *ServerInterfaceWrapper) GetVertexStartupOrder(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "key" -------------
+	var key Key
+
+	err = runtime.BindStyledParameterWithOptions("simple", "key", r.PathValue("key"), &key, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "key", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerHttpAuthenticationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetVertexStartupOrderParams
+
+	// ------------- Optional query parameter "edge_class" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "edge_class", r.URL.Query(), &params.EdgeClass)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "edge_class", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "depth" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "depth", r.URL.Query(), &params.Depth)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "depth", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "include_class" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_class", r.URL.Query(), &params.IncludeClass)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "include_class", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "exclude_class" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "exclude_class", r.URL.Query(), &params.ExcludeClass)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "exclude_class", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "stop_at_class" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "stop_at_class", r.URL.Query(), &params.StopAtClass)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "stop_at_class", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetVertexStartupOrder(w, r, key, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListWebhooks operation middleware
+func (siw *ServerInterfaceWrapper) ListWebhooks(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerHttpAuthenticationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListWebhooks(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateWebhook operation middleware
+func (siw *ServerInterfaceWrapper) CreateWebhook(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerHttpAuthenticationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateWebhook(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteWebhook operation middleware
+func (siw *ServerInterfaceWrapper) DeleteWebhook(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id WebhookId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerHttpAuthenticationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteWebhook(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetWebhook operation middleware
+func (siw *ServerInterfaceWrapper) GetWebhook(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id WebhookId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerHttpAuthenticationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetWebhook(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateWebhook operation middleware
+func (siw *ServerInterfaceWrapper) UpdateWebhook(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id WebhookId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerHttpAuthenticationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateWebhook(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListWebhookDeliveries operation middleware
+func (siw *ServerInterfaceWrapper) ListWebhookDeliveries(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id WebhookId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerHttpAuthenticationScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListWebhookDeliveries(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{})
+}
+
+// ServeMux is an abstraction of http.ServeMux.
+type ServeMux interface {
+	HandleFunc(pattern string, handler func(http.ResponseWriter, *http.Request))
+	ServeHTTP(w http.ResponseWriter, r *http.Request)
+}
+
+type StdHTTPServerOptions struct {
+	BaseURL          string
+	BaseRouter       ServeMux
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, m ServeMux) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{
+		BaseRouter: m,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, m ServeMux, baseURL string) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: m,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.Handler {
+	m := options.BaseRouter
+
+	if m == nil {
+		m = http.NewServeMux()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	m.HandleFunc("GET "+options.BaseURL+"/analysis/critical", wrapper.GetCriticalVertices)
+	m.HandleFunc("GET "+options.BaseURL+"/analysis/cycles", wrapper.GetCycles)
+	m.HandleFunc("GET "+options.BaseURL+"/analysis/orphans", wrapper.GetOrphans)
+	m.HandleFunc("GET "+options.BaseURL+"/analysis/spof", wrapper.GetSinglePointsOfFailure)
+	m.HandleFunc("GET "+options.BaseURL+"/edges", wrapper.ListEdges)
+	m.HandleFunc("POST "+options.BaseURL+"/edges", wrapper.CreateEdge)
+	m.HandleFunc("DELETE "+options.BaseURL+"/edges/{key}", wrapper.DeleteEdge)
+	m.HandleFunc("GET "+options.BaseURL+"/edges/{key}", wrapper.GetEdge)
+	m.HandleFunc("PUT "+options.BaseURL+"/edges/{key}", wrapper.UpdateEdge)
+	m.HandleFunc("GET "+options.BaseURL+"/events", wrapper.GetEvents)
+	m.HandleFunc("GET "+options.BaseURL+"/export", wrapper.ExportGraph)
+	m.HandleFunc("POST "+options.BaseURL+"/import", wrapper.ImportGraph)
+	m.HandleFunc("GET "+options.BaseURL+"/propagation/classes", wrapper.ListClassPropagation)
+	m.HandleFunc("DELETE "+options.BaseURL+"/propagation/classes/{class}", wrapper.DeleteClassPropagation)
+	m.HandleFunc("PUT "+options.BaseURL+"/propagation/classes/{class}", wrapper.SetClassPropagation)
+	m.HandleFunc("GET "+options.BaseURL+"/redundancy-groups", wrapper.ListRedundancyGroups)
+	m.HandleFunc("DELETE "+options.BaseURL+"/redundancy-groups/{name}", wrapper.DeleteRedundancyGroup)
+	m.HandleFunc("GET "+options.BaseURL+"/redundancy-groups/{name}", wrapper.GetRedundancyGroup)
+	m.HandleFunc("PUT "+options.BaseURL+"/redundancy-groups/{name}", wrapper.SetRedundancyGroup)
+	m.HandleFunc("POST "+options.BaseURL+"/simulate", wrapper.Simulate)
+	m.HandleFunc("GET "+options.BaseURL+"/startup-order", wrapper.GetStartupOrder)
+	m.HandleFunc("GET "+options.BaseURL+"/summary", wrapper.Summary)
+	m.HandleFunc("GET "+options.BaseURL+"/vertices", wrapper.ListVertices)
+	m.HandleFunc("POST "+options.BaseURL+"/vertices", wrapper.CreateVertex)
+	m.HandleFunc("POST "+options.BaseURL+"/vertices/clear-health-status", wrapper.ClearHealthStatus)
+	m.HandleFunc("DELETE "+options.BaseURL+"/vertices/{key}", wrapper.DeleteVertex)
+	m.HandleFunc("GET "+options.BaseURL+"/vertices/{key}", wrapper.GetVertex)
+	m.HandleFunc("PUT "+options.BaseURL+"/vertices/{key}", wrapper.UpdateVertex)
+	m.HandleFunc("DELETE "+options.BaseURL+"/vertices/{key}/attributes", wrapper.DeleteVertexAttributes)
+	m.HandleFunc("GET "+options.BaseURL+"/vertices/{key}/attributes", wrapper.GetVertexAttributes)
+	m.HandleFunc("PATCH "+options.BaseURL+"/vertices/{key}/attributes", wrapper.UpdateVertexAttributes)
+	m.HandleFunc("PUT "+options.BaseURL+"/vertices/{key}/attributes", wrapper.ReplaceVertexAttributes)
+	m.HandleFunc("GET "+options.BaseURL+"/vertices/{key}/dependencies", wrapper.GetVertexDependencies)
+	m.HandleFunc("GET "+options.BaseURL+"/vertices/{key}/dependents", wrapper.GetVertexDependents)
+	m.HandleFunc("DELETE "+options.BaseURL+"/vertices/{key}/healthy", wrapper.MarkVertexUnhealthy)
+	m.HandleFunc("POST "+options.BaseURL+"/vertices/{key}/healthy", wrapper.MarkVertexHealthy)
+	m.HandleFunc("GET "+options.BaseURL+"/vertices/{key}/history", wrapper.GetVertexHistory)
+	m.HandleFunc("GET "+options.BaseURL+"/vertices/{key}/impact", wrapper.GetVertexImpact)
+	m.HandleFunc("GET "+options.BaseURL+"/vertices/{key}/neighbors", wrapper.GetVertexNeighbors)
+	m.HandleFunc("GET "+options.BaseURL+"/vertices/{key}/path/{target}", wrapper.GetPath)
+	m.HandleFunc("GET "+options.BaseURL+"/vertices/{key}/paths/{target}", wrapper.GetPaths)
+	m.HandleFunc("DELETE "+options.BaseURL+"/vertices/{key}/propagation", wrapper.DeleteVertexPropagation)
+	m.HandleFunc("GET "+options.BaseURL+"/vertices/{key}/propagation", wrapper.GetVertexPropagation)
+	m.HandleFunc("PUT "+options.BaseURL+"/vertices/{key}/propagation", wrapper.SetVertexPropagation)
+	m.HandleFunc("GET "+options.BaseURL+"/vertices/{key}/root-cause", wrapper.GetVertexRootCause)
+	m.HandleFunc("GET "+options.BaseURL+"/vertices/{key}/startup-order", wrapper.GetVertexStartupOrder)
+	m.HandleFunc("GET "+options.BaseURL+"/webhooks", wrapper.ListWebhooks)
+	m.HandleFunc("POST "+options.BaseURL+"/webhooks", wrapper.CreateWebhook)
+	m.HandleFunc("DELETE "+options.BaseURL+"/webhooks/{id}", wrapper.DeleteWebhook)
+	m.HandleFunc("GET "+options.BaseURL+"/webhooks/{id}", wrapper.GetWebhook)
+	m.HandleFunc("PUT "+options.BaseURL+"/webhooks/{id}", wrapper.UpdateWebhook)
+	m.HandleFunc("GET "+options.BaseURL+"/webhooks/{id}/deliveries", wrapper.ListWebhookDeliveries)
+
+	return m
+}
+
+type ForbiddenJSONResponse struct {
+	// Code Código do erro
+	Code int `json:"code"`
+
+	// Error Mensagem de erro
+	Error string `json:"error"`
+}
+
+type InternalServerErrorJSONResponse struct {
+	// Code Código do erro
+	Code int `json:"code"`
+
+	// Error Mensagem de erro
+	Error string `json:"error"`
+}
+
+type InvalidRequestJSONResponse struct {
+	// Code Código do erro
+	Code int `json:"code"`
+
+	// Error Mensagem de erro
+	Error string `json:"error"`
+}
+
+type NoPathJSONResponse NoPath
+
+type NotFoundJSONResponse struct {
+	// Code Código do erro
+	Code int `json:"code"`
+
+	// Error Mensagem de erro
+	Error string `json:"error"`
+}
+
+type UnauthorizedJSONResponse struct {
+	// Code Código do erro
+	Code int `json:"code"`
+
+	// Error Mensagem de erro
+	Error string `json:"error"`
+}
+
+type GetCriticalVerticesRequestObject struct {
+	Params GetCriticalVerticesParams
+}
+
+type GetCriticalVerticesResponseObject interface {
+	VisitGetCriticalVerticesResponse(w http.ResponseWriter) error
+}
+
+type GetCriticalVertices200JSONResponse CriticalVertices
+
+func (response GetCriticalVertices200JSONResponse) VisitGetCriticalVerticesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCriticalVertices401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetCriticalVertices401JSONResponse) VisitGetCriticalVerticesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCriticalVertices403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetCriticalVertices403JSONResponse) VisitGetCriticalVerticesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCriticalVertices422JSONResponse struct{ InvalidRequestJSONResponse }
+
+func (response GetCriticalVertices422JSONResponse) VisitGetCriticalVerticesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCriticalVertices500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetCriticalVertices500JSONResponse) VisitGetCriticalVerticesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCyclesRequestObject struct {
+}
+
+type GetCyclesResponseObject interface {
+	VisitGetCyclesResponse(w http.ResponseWriter) error
+}
+
+type GetCycles200JSONResponse Cycles
+
+func (response GetCycles200JSONResponse) VisitGetCyclesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCycles401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetCycles401JSONResponse) VisitGetCyclesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCycles403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetCycles403JSONResponse) VisitGetCyclesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCycles500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetCycles500JSONResponse) VisitGetCyclesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrphansRequestObject struct {
+}
+
+type GetOrphansResponseObject interface {
+	VisitGetOrphansResponse(w http.ResponseWriter) error
+}
+
+type GetOrphans200JSONResponse Orphans
+
+func (response GetOrphans200JSONResponse) VisitGetOrphansResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrphans401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetOrphans401JSONResponse) VisitGetOrphansResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrphans403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetOrphans403JSONResponse) VisitGetOrphansResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrphans500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetOrphans500JSONResponse) VisitGetOrphansResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSinglePointsOfFailureRequestObject struct {
+}
+
+type GetSinglePointsOfFailureResponseObject interface {
+	VisitGetSinglePointsOfFailureResponse(w http.ResponseWriter) error
+}
+
+type GetSinglePointsOfFailure200JSONResponse SinglePointsOfFailure
+
+func (response GetSinglePointsOfFailure200JSONResponse) VisitGetSinglePointsOfFailureResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSinglePointsOfFailure401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetSinglePointsOfFailure401JSONResponse) VisitGetSinglePointsOfFailureResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSinglePointsOfFailure403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetSinglePointsOfFailure403JSONResponse) VisitGetSinglePointsOfFailureResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSinglePointsOfFailure500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetSinglePointsOfFailure500JSONResponse) VisitGetSinglePointsOfFailureResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListEdgesRequestObject struct {
+}
+
+type ListEdgesResponseObject interface {
+	VisitListEdgesResponse(w http.ResponseWriter) error
+}
+
+type ListEdges200JSONResponse []Edge
+
+func (response ListEdges200JSONResponse) VisitListEdgesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListEdges401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListEdges401JSONResponse) VisitListEdgesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListEdges403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ListEdges403JSONResponse) VisitListEdgesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListEdges500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ListEdges500JSONResponse) VisitListEdgesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateEdgeRequestObject struct {
+	Body *CreateEdgeJSONRequestBody
+}
+
+type CreateEdgeResponseObject interface {
+	VisitCreateEdgeResponse(w http.ResponseWriter) error
+}
+
+type CreateEdge201JSONResponse Edge
+
+func (response CreateEdge201JSONResponse) VisitCreateEdgeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateEdge401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response CreateEdge401JSONResponse) VisitCreateEdgeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateEdge403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response CreateEdge403JSONResponse) VisitCreateEdgeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateEdge422JSONResponse struct{ InvalidRequestJSONResponse }
+
+func (response CreateEdge422JSONResponse) VisitCreateEdgeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateEdge500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response CreateEdge500JSONResponse) VisitCreateEdgeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteEdgeRequestObject struct {
+	Key EdgeKey `json:"key"`
+}
+
+type DeleteEdgeResponseObject interface {
+	VisitDeleteEdgeResponse(w http.ResponseWriter) error
+}
+
+type DeleteEdge200Response struct {
+}
+
+func (response DeleteEdge200Response) VisitDeleteEdgeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type DeleteEdge401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response DeleteEdge401JSONResponse) VisitDeleteEdgeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteEdge403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response DeleteEdge403JSONResponse) VisitDeleteEdgeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteEdge404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response DeleteEdge404JSONResponse) VisitDeleteEdgeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteEdge422JSONResponse struct{ InvalidRequestJSONResponse }
+
+func (response DeleteEdge422JSONResponse) VisitDeleteEdgeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteEdge500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response DeleteEdge500JSONResponse) VisitDeleteEdgeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEdgeRequestObject struct {
+	Key EdgeKey `json:"key"`
+}
+
+type GetEdgeResponseObject interface {
+	VisitGetEdgeResponse(w http.ResponseWriter) error
+}
+
+type GetEdge200JSONResponse Edge
+
+func (response GetEdge200JSONResponse) VisitGetEdgeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEdge401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetEdge401JSONResponse) VisitGetEdgeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEdge403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetEdge403JSONResponse) VisitGetEdgeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEdge404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetEdge404JSONResponse) VisitGetEdgeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEdge422JSONResponse struct{ InvalidRequestJSONResponse }
+
+func (response GetEdge422JSONResponse) VisitGetEdgeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEdge500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetEdge500JSONResponse) VisitGetEdgeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateEdgeRequestObject struct {
+	Key  EdgeKey `json:"key"`
+	Body *UpdateEdgeJSONRequestBody
+}
+
+type UpdateEdgeResponseObject interface {
+	VisitUpdateEdgeResponse(w http.ResponseWriter) error
+}
+
+type UpdateEdge200JSONResponse Edge
+
+func (response UpdateEdge200JSONResponse) VisitUpdateEdgeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateEdge401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response UpdateEdge401JSONResponse) VisitUpdateEdgeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateEdge403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response UpdateEdge403JSONResponse) VisitUpdateEdgeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateEdge404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response UpdateEdge404JSONResponse) VisitUpdateEdgeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateEdge422JSONResponse struct{ InvalidRequestJSONResponse }
+
+func (response UpdateEdge422JSONResponse) VisitUpdateEdgeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateEdge500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response UpdateEdge500JSONResponse) VisitUpdateEdgeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEventsRequestObject struct {
+	Params GetEventsParams
+}
+
+type GetEventsResponseObject interface {
+	VisitGetEventsResponse(w http.ResponseWriter) error
+}
+
+type GetEvents200TexteventStreamResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetEvents200TexteventStreamResponse) VisitGetEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/event-stream")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetEvents401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetEvents401JSONResponse) VisitGetEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEvents403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetEvents403JSONResponse) VisitGetEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEvents404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetEvents404JSONResponse) VisitGetEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEvents422JSONResponse struct{ InvalidRequestJSONResponse }
+
+func (response GetEvents422JSONResponse) VisitGetEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEvents500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetEvents500JSONResponse) VisitGetEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportGraphRequestObject struct {
+	Params ExportGraphParams
+}
+
+type ExportGraphResponseObject interface {
+	VisitExportGraphResponse(w http.ResponseWriter) error
+}
+
+type ExportGraph200ApplicationgraphmlXmlResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response ExportGraph200ApplicationgraphmlXmlResponse) VisitExportGraphResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/graphml+xml")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type ExportGraph200JSONResponse CytoscapeGraph
+
+func (response ExportGraph200JSONResponse) VisitExportGraphResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportGraph200TextvndGraphvizResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response ExportGraph200TextvndGraphvizResponse) VisitExportGraphResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/vnd.graphviz")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type ExportGraph200TextvndMermaidResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response ExportGraph200TextvndMermaidResponse) VisitExportGraphResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/vnd.mermaid")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type ExportGraph401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ExportGraph401JSONResponse) VisitExportGraphResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportGraph403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ExportGraph403JSONResponse) VisitExportGraphResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportGraph404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ExportGraph404JSONResponse) VisitExportGraphResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportGraph409JSONResponse struct{ NoPathJSONResponse }
+
+func (response ExportGraph409JSONResponse) VisitExportGraphResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportGraph422JSONResponse struct{ InvalidRequestJSONResponse }
+
+func (response ExportGraph422JSONResponse) VisitExportGraphResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportGraph500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ExportGraph500JSONResponse) VisitExportGraphResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportGraphRequestObject struct {
+	Params        ImportGraphParams
+	Body          io.Reader
+	JSONBody      *ImportGraphJSONRequestBody
+	MultipartBody *multipart.Reader
+}
+
+type ImportGraphResponseObject interface {
+	VisitImportGraphResponse(w http.ResponseWriter) error
+}
+
+type ImportGraph200JSONResponse ImportReport
+
+func (response ImportGraph200JSONResponse) VisitImportGraphResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportGraph401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ImportGraph401JSONResponse) VisitImportGraphResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportGraph403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ImportGraph403JSONResponse) VisitImportGraphResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportGraph422JSONResponse struct{ InvalidRequestJSONResponse }
+
+func (response ImportGraph422JSONResponse) VisitImportGraphResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportGraph500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ImportGraph500JSONResponse) VisitImportGraphResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListClassPropagationRequestObject struct {
+}
+
+type ListClassPropagationResponseObject interface {
+	VisitListClassPropagationResponse(w http.ResponseWriter) error
+}
+
+type ListClassPropagation200JSONResponse []ClassPropagation
+
+func (response ListClassPropagation200JSONResponse) VisitListClassPropagationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListClassPropagation401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListClassPropagation401JSONResponse) VisitListClassPropagationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListClassPropagation403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ListClassPropagation403JSONResponse) VisitListClassPropagationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListClassPropagation500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ListClassPropagation500JSONResponse) VisitListClassPropagationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteClassPropagationRequestObject struct {
+	Class string `json:"class"`
+}
+
+type DeleteClassPropagationResponseObject interface {
+	VisitDeleteClassPropagationResponse(w http.ResponseWriter) error
+}
+
+type DeleteClassPropagation200Response struct {
+}
+
+func (response DeleteClassPropagation200Response) VisitDeleteClassPropagationResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type DeleteClassPropagation401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response DeleteClassPropagation401JSONResponse) VisitDeleteClassPropagationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteClassPropagation403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response DeleteClassPropagation403JSONResponse) VisitDeleteClassPropagationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteClassPropagation404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response DeleteClassPropagation404JSONResponse) VisitDeleteClassPropagationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteClassPropagation500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response DeleteClassPropagation500JSONResponse) VisitDeleteClassPropagationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetClassPropagationRequestObject struct {
+	Class string `json:"class"`
+	Body  *SetClassPropagationJSONRequestBody
+}
+
+type SetClassPropagationResponseObject interface {
+	VisitSetClassPropagationResponse(w http.ResponseWriter) error
+}
+
+type SetClassPropagation200JSONResponse ClassPropagation
+
+func (response SetClassPropagation200JSONResponse) VisitSetClassPropagationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetClassPropagation401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response SetClassPropagation401JSONResponse) VisitSetClassPropagationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetClassPropagation403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response SetClassPropagation403JSONResponse) VisitSetClassPropagationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetClassPropagation422JSONResponse struct{ InvalidRequestJSONResponse }
+
+func (response SetClassPropagation422JSONResponse) VisitSetClassPropagationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetClassPropagation500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response SetClassPropagation500JSONResponse) VisitSetClassPropagationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRedundancyGroupsRequestObject struct {
+}
+
+type ListRedundancyGroupsResponseObject interface {
+	VisitListRedundancyGroupsResponse(w http.ResponseWriter) error
+}
+
+type ListRedundancyGroups200JSONResponse []RedundancyGroup
+
+func (response ListRedundancyGroups200JSONResponse) VisitListRedundancyGroupsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRedundancyGroups401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListRedundancyGroups401JSONResponse) VisitListRedundancyGroupsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRedundancyGroups403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ListRedundancyGroups403JSONResponse) VisitListRedundancyGroupsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRedundancyGroups500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ListRedundancyGroups500JSONResponse) VisitListRedundancyGroupsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteRedundancyGroupRequestObject struct {
+	Name string `json:"name"`
+}
+
+type DeleteRedundancyGroupResponseObject interface {
+	VisitDeleteRedundancyGroupResponse(w http.ResponseWriter) error
+}
+
+type DeleteRedundancyGroup200Response struct {
+}
+
+func (response DeleteRedundancyGroup200Response) VisitDeleteRedundancyGroupResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type DeleteRedundancyGroup401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response DeleteRedundancyGroup401JSONResponse) VisitDeleteRedundancyGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteRedundancyGroup403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response DeleteRedundancyGroup403JSONResponse) VisitDeleteRedundancyGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteRedundancyGroup404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response DeleteRedundancyGroup404JSONResponse) VisitDeleteRedundancyGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteRedundancyGroup500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response DeleteRedundancyGroup500JSONResponse) VisitDeleteRedundancyGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRedundancyGroupRequestObject struct {
+	Name string `json:"name"`
+}
+
+type GetRedundancyGroupResponseObject interface {
+	VisitGetRedundancyGroupResponse(w http.ResponseWriter) error
+}
+
+type GetRedundancyGroup200JSONResponse RedundancyGroup
+
+func (response GetRedundancyGroup200JSONResponse) VisitGetRedundancyGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRedundancyGroup401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetRedundancyGroup401JSONResponse) VisitGetRedundancyGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRedundancyGroup403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetRedundancyGroup403JSONResponse) VisitGetRedundancyGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRedundancyGroup404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetRedundancyGroup404JSONResponse) VisitGetRedundancyGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRedundancyGroup500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetRedundancyGroup500JSONResponse) VisitGetRedundancyGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetRedundancyGroupRequestObject struct {
+	Name string `json:"name"`
+	Body *SetRedundancyGroupJSONRequestBody
+}
+
+type SetRedundancyGroupResponseObject interface {
+	VisitSetRedundancyGroupResponse(w http.ResponseWriter) error
+}
+
+type SetRedundancyGroup200JSONResponse RedundancyGroup
+
+func (response SetRedundancyGroup200JSONResponse) VisitSetRedundancyGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetRedundancyGroup401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response SetRedundancyGroup401JSONResponse) VisitSetRedundancyGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetRedundancyGroup403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response SetRedundancyGroup403JSONResponse) VisitSetRedundancyGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetRedundancyGroup422JSONResponse struct{ InvalidRequestJSONResponse }
+
+func (response SetRedundancyGroup422JSONResponse) VisitSetRedundancyGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetRedundancyGroup500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response SetRedundancyGroup500JSONResponse) VisitSetRedundancyGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SimulateRequestObject struct {
+	Body *SimulateJSONRequestBody
+}
+
+type SimulateResponseObject interface {
+	VisitSimulateResponse(w http.ResponseWriter) error
+}
+
+type Simulate200JSONResponse SimulationResult
+
+func (response Simulate200JSONResponse) VisitSimulateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Simulate401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response Simulate401JSONResponse) VisitSimulateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Simulate403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response Simulate403JSONResponse) VisitSimulateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Simulate404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response Simulate404JSONResponse) VisitSimulateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Simulate409JSONResponse struct{ NoPathJSONResponse }
+
+func (response Simulate409JSONResponse) VisitSimulateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Simulate422JSONResponse struct{ InvalidRequestJSONResponse }
+
+func (response Simulate422JSONResponse) VisitSimulateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Simulate500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response Simulate500JSONResponse) VisitSimulateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStartupOrderRequestObject struct {
+}
+
+type GetStartupOrderResponseObject interface {
+	VisitGetStartupOrderResponse(w http.ResponseWriter) error
+}
+
+type GetStartupOrder200JSONResponse StartupOrder
+
+func (response GetStartupOrder200JSONResponse) VisitGetStartupOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStartupOrder401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetStartupOrder401JSONResponse) VisitGetStartupOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStartupOrder403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetStartupOrder403JSONResponse) VisitGetStartupOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStartupOrder500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetStartupOrder500JSONResponse) VisitGetStartupOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SummaryRequestObject struct {
+	Params SummaryParams
+}
+
+type SummaryResponseObject interface {
+	VisitSummaryResponse(w http.ResponseWriter) error
+}
+
+type Summary200JSONResponse Summary
+
+func (response Summary200JSONResponse) VisitSummaryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Summary401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response Summary401JSONResponse) VisitSummaryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Summary403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response Summary403JSONResponse) VisitSummaryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Summary422JSONResponse struct{ InvalidRequestJSONResponse }
+
+func (response Summary422JSONResponse) VisitSummaryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Summary500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response Summary500JSONResponse) VisitSummaryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListVerticesRequestObject struct {
+	Params ListVerticesParams
+}
+
+type ListVerticesResponseObject interface {
+	VisitListVerticesResponse(w http.ResponseWriter) error
+}
+
+type ListVertices200JSONResponse VertexPage
+
+func (response ListVertices200JSONResponse) VisitListVerticesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListVertices401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListVertices401JSONResponse) VisitListVerticesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListVertices403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ListVertices403JSONResponse) VisitListVerticesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListVertices422JSONResponse struct{ InvalidRequestJSONResponse }
+
+func (response ListVertices422JSONResponse) VisitListVerticesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListVertices500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ListVertices500JSONResponse) VisitListVerticesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateVertexRequestObject struct {
+	Body *CreateVertexJSONRequestBody
+}
+
+type CreateVertexResponseObject interface {
+	VisitCreateVertexResponse(w http.ResponseWriter) error
+}
+
+type CreateVertex201JSONResponse Vertex
+
+func (response CreateVertex201JSONResponse) VisitCreateVertexResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateVertex401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response CreateVertex401JSONResponse) VisitCreateVertexResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateVertex403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response CreateVertex403JSONResponse) VisitCreateVertexResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateVertex422JSONResponse struct{ InvalidRequestJSONResponse }
+
+func (response CreateVertex422JSONResponse) VisitCreateVertexResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateVertex500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response CreateVertex500JSONResponse) VisitCreateVertexResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ClearHealthStatusRequestObject struct {
+}
+
+type ClearHealthStatusResponseObject interface {
+	VisitClearHealthStatusResponse(w http.ResponseWriter) error
+}
+
+type ClearHealthStatus200Response struct {
+}
+
+func (response ClearHealthStatus200Response) VisitClearHealthStatusResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type ClearHealthStatus401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ClearHealthStatus401JSONResponse) VisitClearHealthStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ClearHealthStatus403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ClearHealthStatus403JSONResponse) VisitClearHealthStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ClearHealthStatus500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ClearHealthStatus500JSONResponse) VisitClearHealthStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteVertexRequestObject struct {
+	Key Key `json:"key"`
+}
+
+type DeleteVertexResponseObject interface {
+	VisitDeleteVertexResponse(w http.ResponseWriter) error
+}
+
+type DeleteVertex200Response struct {
+}
+
+func (response DeleteVertex200Response) VisitDeleteVertexResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type DeleteVertex401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response DeleteVertex401JSONResponse) VisitDeleteVertexResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteVertex403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response DeleteVertex403JSONResponse) VisitDeleteVertexResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteVertex404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response DeleteVertex404JSONResponse) VisitDeleteVertexResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteVertex422JSONResponse struct{ InvalidRequestJSONResponse }
+
+func (response DeleteVertex422JSONResponse) VisitDeleteVertexResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteVertex500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response DeleteVertex500JSONResponse) VisitDeleteVertexResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVertexRequestObject struct {
+	Key Key `json:"key"`
+}
+
+type GetVertexResponseObject interface {
+	VisitGetVertexResponse(w http.ResponseWriter) error
+}
+
+type GetVertex200JSONResponse Vertex
+
+func (response GetVertex200JSONResponse) VisitGetVertexResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVertex401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetVertex401JSONResponse) VisitGetVertexResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVertex403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetVertex403JSONResponse) VisitGetVertexResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVertex404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetVertex404JSONResponse) VisitGetVertexResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVertex422JSONResponse struct{ InvalidRequestJSONResponse }
+
+func (response GetVertex422JSONResponse) VisitGetVertexResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVertex500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetVertex500JSONResponse) VisitGetVertexResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateVertexRequestObject struct {
+	Key  Key `json:"key"`
+	Body *UpdateVertexJSONRequestBody
+}
+
+type UpdateVertexResponseObject interface {
+	VisitUpdateVertexResponse(w http.ResponseWriter) error
+}
+
+type UpdateVertex200JSONResponse Vertex
+
+func (response UpdateVertex200JSONResponse) VisitUpdateVertexResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateVertex401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response UpdateVertex401JSONResponse) VisitUpdateVertexResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateVertex403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response UpdateVertex403JSONResponse) VisitUpdateVertexResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateVertex404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response UpdateVertex404JSONResponse) VisitUpdateVertexResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateVertex422JSONResponse struct{ InvalidRequestJSONResponse }
+
+func (response UpdateVertex422JSONResponse) VisitUpdateVertexResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateVertex500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response UpdateVertex500JSONResponse) VisitUpdateVertexResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteVertexAttributesRequestObject struct {
+	Key    Key `json:"key"`
+	Params DeleteVertexAttributesParams
+}
+
+type DeleteVertexAttributesResponseObject interface {
+	VisitDeleteVertexAttributesResponse(w http.ResponseWriter) error
+}
+
+type DeleteVertexAttributes200JSONResponse VertexAttrubutes
+
+func (response DeleteVertexAttributes200JSONResponse) VisitDeleteVertexAttributesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteVertexAttributes401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response DeleteVertexAttributes401JSONResponse) VisitDeleteVertexAttributesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteVertexAttributes403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response DeleteVertexAttributes403JSONResponse) VisitDeleteVertexAttributesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteVertexAttributes404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response DeleteVertexAttributes404JSONResponse) VisitDeleteVertexAttributesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteVertexAttributes422JSONResponse struct{ InvalidRequestJSONResponse }
+
+func (response DeleteVertexAttributes422JSONResponse) VisitDeleteVertexAttributesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteVertexAttributes500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response DeleteVertexAttributes500JSONResponse) VisitDeleteVertexAttributesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVertexAttributesRequestObject struct {
+	Key Key `json:"key"`
+}
+
+type GetVertexAttributesResponseObject interface {
+	VisitGetVertexAttributesResponse(w http.ResponseWriter) error
+}
+
+type GetVertexAttributes200JSONResponse VertexAttrubutes
+
+func (response GetVertexAttributes200JSONResponse) VisitGetVertexAttributesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVertexAttributes401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetVertexAttributes401JSONResponse) VisitGetVertexAttributesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVertexAttributes403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetVertexAttributes403JSONResponse) VisitGetVertexAttributesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVertexAttributes404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetVertexAttributes404JSONResponse) VisitGetVertexAttributesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVertexAttributes422JSONResponse struct{ InvalidRequestJSONResponse }
+
+func (response GetVertexAttributes422JSONResponse) VisitGetVertexAttributesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVertexAttributes500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetVertexAttributes500JSONResponse) VisitGetVertexAttributesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateVertexAttributesRequestObject struct {
+	Key  Key `json:"key"`
+	Body *UpdateVertexAttributesJSONRequestBody
+}
+
+type UpdateVertexAttributesResponseObject interface {
+	VisitUpdateVertexAttributesResponse(w http.ResponseWriter) error
+}
+
+type UpdateVertexAttributes200JSONResponse VertexAttrubutes
+
+func (response UpdateVertexAttributes200JSONResponse) VisitUpdateVertexAttributesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateVertexAttributes401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response UpdateVertexAttributes401JSONResponse) VisitUpdateVertexAttributesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateVertexAttributes403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response UpdateVertexAttributes403JSONResponse) VisitUpdateVertexAttributesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateVertexAttributes404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response UpdateVertexAttributes404JSONResponse) VisitUpdateVertexAttributesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateVertexAttributes422JSONResponse struct{ InvalidRequestJSONResponse }
+
+func (response UpdateVertexAttributes422JSONResponse) VisitUpdateVertexAttributesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateVertexAttributes500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response UpdateVertexAttributes500JSONResponse) VisitUpdateVertexAttributesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReplaceVertexAttributesRequestObject struct {
+	Key  Key `json:"key"`
+	Body *ReplaceVertexAttributesJSONRequestBody
+}
+
+type ReplaceVertexAttributesResponseObject interface {
+	VisitReplaceVertexAttributesResponse(w http.ResponseWriter) error
+}
+
+type ReplaceVertexAttributes200JSONResponse VertexAttrubutes
+
+func (response ReplaceVertexAttributes200JSONResponse) VisitReplaceVertexAttributesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReplaceVertexAttributes401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ReplaceVertexAttributes401JSONResponse) VisitReplaceVertexAttributesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReplaceVertexAttributes403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ReplaceVertexAttributes403JSONResponse) VisitReplaceVertexAttributesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReplaceVertexAttributes404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ReplaceVertexAttributes404JSONResponse) VisitReplaceVertexAttributesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReplaceVertexAttributes422JSONResponse struct{ InvalidRequestJSONResponse }
+
+func (response ReplaceVertexAttributes422JSONResponse) VisitReplaceVertexAttributesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReplaceVertexAttributes500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ReplaceVertexAttributes500JSONResponse) VisitReplaceVertexAttributesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVertexDependenciesRequestObject struct {
+	Key    Key `json:"key"`
+	Params GetVertexDependenciesParams
+}
+
+type GetVertexDependenciesResponseObject interface {
+	VisitGetVertexDependenciesResponse(w http.ResponseWriter) error
+}
+
+type GetVertexDependencies200JSONResponse Subgraph
+
+func (response GetVertexDependencies200JSONResponse) VisitGetVertexDependenciesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVertexDependencies401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetVertexDependencies401JSONResponse) VisitGetVertexDependenciesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVertexDependencies403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetVertexDependencies403JSONResponse) VisitGetVertexDependenciesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVertexDependencies404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetVertexDependencies404JSONResponse) VisitGetVertexDependenciesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVertexDependencies422JSONResponse struct{ InvalidRequestJSONResponse }
+
+func (response GetVertexDependencies422JSONResponse) VisitGetVertexDependenciesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVertexDependencies500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetVertexDependencies500JSONResponse) VisitGetVertexDependenciesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVertexDependentsRequestObject struct {
+	Key    Key `json:"key"`
+	Params GetVertexDependentsParams
+}
+
+type GetVertexDependentsResponseObject interface {
+	VisitGetVertexDependentsResponse(w http.ResponseWriter) error
+}
+
+type GetVertexDependents200JSONResponse Subgraph
+
+func (response GetVertexDependents200JSONResponse) VisitGetVertexDependentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVertexDependents401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetVertexDependents401JSONResponse) VisitGetVertexDependentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVertexDependents403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetVertexDependents403JSONResponse) VisitGetVertexDependentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVertexDependents404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetVertexDependents404JSONResponse) VisitGetVertexDependentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVertexDependents422JSONResponse struct{ InvalidRequestJSONResponse }
+
+func (response GetVertexDependents422JSONResponse) VisitGetVertexDependentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVertexDependents500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetVertexDependents500JSONResponse) VisitGetVertexDependentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MarkVertexUnhealthyRequestObject struct {
+	Key      Key `json:"key"`
+	Params   MarkVertexUnhealthyParams
+	JSONBody *MarkVertexUnhealthyJSONRequestBody
+	TextBody *MarkVertexUnhealthyTextRequestBody
+}
+
+type MarkVertexUnhealthyResponseObject interface {
+	VisitMarkVertexUnhealthyResponse(w http.ResponseWriter) error
+}
+
+type MarkVertexUnhealthy200Response struct {
+}
+
+func (response MarkVertexUnhealthy200Response) VisitMarkVertexUnhealthyResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type MarkVertexUnhealthy401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response MarkVertexUnhealthy401JSONResponse) VisitMarkVertexUnhealthyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MarkVertexUnhealthy403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response MarkVertexUnhealthy403JSONResponse) VisitMarkVertexUnhealthyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MarkVertexUnhealthy404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response MarkVertexUnhealthy404JSONResponse) VisitMarkVertexUnhealthyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MarkVertexUnhealthy422JSONResponse struct{ InvalidRequestJSONResponse }
+
+func (response MarkVertexUnhealthy422JSONResponse) VisitMarkVertexUnhealthyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MarkVertexUnhealthy500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response MarkVertexUnhealthy500JSONResponse) VisitMarkVertexUnhealthyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
 type MarkVertexHealthyRequestObject struct {
+	Key    Key `json:"key"`
+	Params MarkVertexHealthyParams
+}
+
+type MarkVertexHealthyResponseObject interface {
+	VisitMarkVertexHealthyResponse(w http.ResponseWriter) error
+}
+
+type MarkVertexHealthy200Response struct {
+}
+
+func (response MarkVertexHealthy200Response) VisitMarkVertexHealthyResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type MarkVertexHealthy401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response MarkVertexHealthy401JSONResponse) VisitMarkVertexHealthyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MarkVertexHealthy403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response MarkVertexHealthy403JSONResponse) VisitMarkVertexHealthyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MarkVertexHealthy404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response MarkVertexHealthy404JSONResponse) VisitMarkVertexHealthyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MarkVertexHealthy422JSONResponse struct{ InvalidRequestJSONResponse }
+
+func (response MarkVertexHealthy422JSONResponse) VisitMarkVertexHealthyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MarkVertexHealthy500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response MarkVertexHealthy500JSONResponse) VisitMarkVertexHealthyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVertexHistoryRequestObject struct {
+	Key    Key `json:"key"`
+	Params GetVertexHistoryParams
+}
+
+type GetVertexHistoryResponseObject interface {
+	VisitGetVertexHistoryResponse(w http.ResponseWriter) error
+}
+
+type GetVertexHistory200JSONResponse HealthHistory
+
+func (response GetVertexHistory200JSONResponse) VisitGetVertexHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVertexHistory401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetVertexHistory401JSONResponse) VisitGetVertexHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVertexHistory403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetVertexHistory403JSONResponse) VisitGetVertexHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVertexHistory404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetVertexHistory404JSONResponse) VisitGetVertexHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVertexHistory422JSONResponse struct{ InvalidRequestJSONResponse }
+
+func (response GetVertexHistory422JSONResponse) VisitGetVertexHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVertexHistory500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetVertexHistory500JSONResponse) VisitGetVertexHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVertexImpactRequestObject struct {
+	Key    Key `json:"key"`
+	Params GetVertexImpactParams
+}
+
+type GetVertexImpactResponseObject interface {
+	VisitGetVertexImpactResponse(w http.ResponseWriter) error
+}
+
+type GetVertexImpact200JSONResponse Impact
+
+func (response GetVertexImpact200JSONResponse) VisitGetVertexImpactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVertexImpact401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetVertexImpact401JSONResponse) VisitGetVertexImpactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVertexImpact403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetVertexImpact403JSONResponse) VisitGetVertexImpactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVertexImpact404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetVertexImpact404JSONResponse) VisitGetVertexImpactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVertexImpact500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetVertexImpact500JSONResponse) VisitGetVertexImpactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVertexNeighborsRequestObject struct {
+	Key    Key `json:"key"`
+	Params GetVertexNeighborsParams
+}
+
+type GetVertexNeighborsResponseObject interface {
+	VisitGetVertexNeighborsResponse(w http.ResponseWriter) error
+}
+
+type GetVertexNeighbors200JSONResponse Subgraph
+
+func (response GetVertexNeighbors200JSONResponse) VisitGetVertexNeighborsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVertexNeighbors401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetVertexNeighbors401JSONResponse) VisitGetVertexNeighborsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVertexNeighbors403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetVertexNeighbors403JSONResponse) VisitGetVertexNeighborsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVertexNeighbors404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetVertexNeighbors404JSONResponse) VisitGetVertexNeighborsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVertexNeighbors422JSONResponse struct{ InvalidRequestJSONResponse }
+
+func (response GetVertexNeighbors422JSONResponse) VisitGetVertexNeighborsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVertexNeighbors500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetVertexNeighbors500JSONResponse) VisitGetVertexNeighborsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPathRequestObject struct {
+	Key    Key    `json:"key"`
+	Target string `json:"target"`
+	Params GetPathParams
+}
+
+type GetPathResponseObject interface {
+	VisitGetPathResponse(w http.ResponseWriter) error
+}
+
+type GetPath200JSONResponse Path
+
+func (response GetPath200JSONResponse) VisitGetPathResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPath401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetPath401JSONResponse) VisitGetPathResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPath403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetPath403JSONResponse) VisitGetPathResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPath404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetPath404JSONResponse) VisitGetPathResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPath409JSONResponse struct{ NoPathJSONResponse }
+
+func (response GetPath409JSONResponse) VisitGetPathResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPath422JSONResponse struct{ InvalidRequestJSONResponse }
+
+func (response GetPath422JSONResponse) VisitGetPathResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPath500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetPath500JSONResponse) VisitGetPathResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPathsRequestObject struct {
+	Key    Key    `json:"key"`
+	Target string `json:"target"`
+	Params GetPathsParams
+}
+
+type GetPathsResponseObject interface {
+	VisitGetPathsResponse(w http.ResponseWriter) error
+}
+
+type GetPaths200JSONResponse Paths
+
+func (response GetPaths200JSONResponse) VisitGetPathsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPaths401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetPaths401JSONResponse) VisitGetPathsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPaths403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetPaths403JSONResponse) VisitGetPathsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPaths404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetPaths404JSONResponse) VisitGetPathsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPaths422JSONResponse struct{ InvalidRequestJSONResponse }
+
+func (response GetPaths422JSONResponse) VisitGetPathsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPaths500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetPaths500JSONResponse) VisitGetPathsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteVertexPropagationRequestObject struct {
+	Key Key `json:"key"`
+}
+
+type DeleteVertexPropagationResponseObject interface {
+	VisitDeleteVertexPropagationResponse(w http.ResponseWriter) error
+}
+
+type DeleteVertexPropagation200JSONResponse ResolvedPropagation
+
+func (response DeleteVertexPropagation200JSONResponse) VisitDeleteVertexPropagationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteVertexPropagation401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response DeleteVertexPropagation401JSONResponse) VisitDeleteVertexPropagationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteVertexPropagation403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response DeleteVertexPropagation403JSONResponse) VisitDeleteVertexPropagationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteVertexPropagation404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response DeleteVertexPropagation404JSONResponse) VisitDeleteVertexPropagationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteVertexPropagation500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response DeleteVertexPropagation500JSONResponse) VisitDeleteVertexPropagationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVertexPropagationRequestObject struct {
+	Key Key `json:"key"`
+}
+
+type GetVertexPropagationResponseObject interface {
+	VisitGetVertexPropagationResponse(w http.ResponseWriter) error
+}
+
+type GetVertexPropagation200JSONResponse ResolvedPropagation
+
+func (response GetVertexPropagation200JSONResponse) VisitGetVertexPropagationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVertexPropagation401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetVertexPropagation401JSONResponse) VisitGetVertexPropagationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVertexPropagation403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetVertexPropagation403JSONResponse) VisitGetVertexPropagationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVertexPropagation404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetVertexPropagation404JSONResponse) VisitGetVertexPropagationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVertexPropagation500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetVertexPropagation500JSONResponse) VisitGetVertexPropagationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetVertexPropagationRequestObject struct {
+	Key  Key `json:"key"`
+	Body *SetVertexPropagationJSONRequestBody
+}
+
+type SetVertexPropagationResponseObject interface {
+	VisitSetVertexPropagationResponse(w http.ResponseWriter) error
+}
+
+type SetVertexPropagation200JSONResponse ResolvedPropagation
+
+func (response SetVertexPropagation200JSONResponse) VisitSetVertexPropagationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetVertexPropagation401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response SetVertexPropagation401JSONResponse) VisitSetVertexPropagationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetVertexPropagation403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response SetVertexPropagation403JSONResponse) VisitSetVertexPropagationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetVertexPropagation404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response SetVertexPropagation404JSONResponse) VisitSetVertexPropagationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetVertexPropagation422JSONResponse struct{ InvalidRequestJSONResponse }
+
+func (response SetVertexPropagation422JSONResponse) VisitSetVertexPropagationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetVertexPropagation500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response SetVertexPropagation500JSONResponse) VisitSetVertexPropagationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVertexRootCauseRequestObject struct {
 	Key Key `json:"key"`
 }
 
-type MarkVertexHealthyResponseObject interface {
-	VisitMarkVertexHealthyResponse(w http.ResponseWriter) error
-}
+type GetVertexRootCauseResponseObject interface {
+	VisitGetVertexRootCauseResponse(w http.ResponseWriter) error
+}
+
+type GetVertexRootCause200JSONResponse RootCause
+
+func (response GetVertexRootCause200JSONResponse) VisitGetVertexRootCauseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVertexRootCause401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetVertexRootCause401JSONResponse) VisitGetVertexRootCauseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVertexRootCause403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetVertexRootCause403JSONResponse) VisitGetVertexRootCauseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVertexRootCause404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetVertexRootCause404JSONResponse) VisitGetVertexRootCauseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVertexRootCause500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetVertexRootCause500JSONResponse) VisitGetVertexRootCauseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVertexStartupOrderRequestObject struct {
+	Key    Key `json:"key"`
+	Params GetVertexStartupOrderParams
+}
+
+type GetVertexStartupOrderResponseObject interface {
+	VisitGetVertexStartupOrderResponse(w http.ResponseWriter) error
+}
+
+type GetVertexStartupOrder200JSONResponse StartupOrder
+
+func (response GetVertexStartupOrder200JSONResponse) VisitGetVertexStartupOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVertexStartupOrder401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetVertexStartupOrder401JSONResponse) VisitGetVertexStartupOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVertexStartupOrder403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetVertexStartupOrder403JSONResponse) VisitGetVertexStartupOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVertexStartupOrder404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetVertexStartupOrder404JSONResponse) VisitGetVertexStartupOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVertexStartupOrder422JSONResponse struct{ InvalidRequestJSONResponse }
+
+func (response GetVertexStartupOrder422JSONResponse) VisitGetVertexStartupOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVertexStartupOrder500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetVertexStartupOrder500JSONResponse) VisitGetVertexStartupOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhooksRequestObject struct {
+}
+
+type ListWebhooksResponseObject interface {
+	VisitListWebhooksResponse(w http.ResponseWriter) error
+}
+
+type ListWebhooks200JSONResponse Webhooks
+
+func (response ListWebhooks200JSONResponse) VisitListWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhooks401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListWebhooks401JSONResponse) VisitListWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhooks403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ListWebhooks403JSONResponse) VisitListWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhooks500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ListWebhooks500JSONResponse) VisitListWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWebhookRequestObject struct {
+	Body *CreateWebhookJSONRequestBody
+}
+
+type CreateWebhookResponseObject interface {
+	VisitCreateWebhookResponse(w http.ResponseWriter) error
+}
+
+type CreateWebhook201JSONResponse Webhook
+
+func (response CreateWebhook201JSONResponse) VisitCreateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWebhook401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response CreateWebhook401JSONResponse) VisitCreateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWebhook403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response CreateWebhook403JSONResponse) VisitCreateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWebhook422JSONResponse struct{ InvalidRequestJSONResponse }
+
+func (response CreateWebhook422JSONResponse) VisitCreateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWebhook500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response CreateWebhook500JSONResponse) VisitCreateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteWebhookRequestObject struct {
+	Id WebhookId `json:"id"`
+}
+
+type DeleteWebhookResponseObject interface {
+	VisitDeleteWebhookResponse(w http.ResponseWriter) error
+}
+
+type DeleteWebhook200Response struct {
+}
+
+func (response DeleteWebhook200Response) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type DeleteWebhook401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response DeleteWebhook401JSONResponse) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteWebhook403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response DeleteWebhook403JSONResponse) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteWebhook404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response DeleteWebhook404JSONResponse) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteWebhook500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response DeleteWebhook500JSONResponse) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhookRequestObject struct {
+	Id WebhookId `json:"id"`
+}
+
+type GetWebhookResponseObject interface {
+	VisitGetWebhookResponse(w http.ResponseWriter) error
+}
+
+type GetWebhook200JSONResponse Webhook
+
+func (response GetWebhook200JSONResponse) VisitGetWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhook401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetWebhook401JSONResponse) VisitGetWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhook403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetWebhook403JSONResponse) VisitGetWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhook404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetWebhook404JSONResponse) VisitGetWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhook500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetWebhook500JSONResponse) VisitGetWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateWebhookRequestObject struct {
+	Id   WebhookId `json:"id"`
+	Body *UpdateWebhookJSONRequestBody
+}
+
+type UpdateWebhookResponseObject interface {
+	VisitUpdateWebhookResponse(w http.ResponseWriter) error
+}
+
+type UpdateWebhook200JSONResponse Webhook
+
+func (response UpdateWebhook200JSONResponse) VisitUpdateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateWebhook401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response UpdateWebhook401JSONResponse) VisitUpdateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateWebhook403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response UpdateWebhook403JSONResponse) VisitUpdateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateWebhook404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response UpdateWebhook404JSONResponse) VisitUpdateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateWebhook422JSONResponse struct{ InvalidRequestJSONResponse }
+
+func (response UpdateWebhook422JSONResponse) VisitUpdateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateWebhook500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response UpdateWebhook500JSONResponse) VisitUpdateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhookDeliveriesRequestObject struct {
+	Id WebhookId `json:"id"`
+}
+
+type ListWebhookDeliveriesResponseObject interface {
+	VisitListWebhookDeliveriesResponse(w http.ResponseWriter) error
+}
+
+type ListWebhookDeliveries200JSONResponse WebhookDeliveries
+
+func (response ListWebhookDeliveries200JSONResponse) VisitListWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhookDeliveries401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListWebhookDeliveries401JSONResponse) VisitListWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhookDeliveries403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ListWebhookDeliveries403JSONResponse) VisitListWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhookDeliveries404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ListWebhookDeliveries404JSONResponse) VisitListWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhookDeliveries500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ListWebhookDeliveries500JSONResponse) VisitListWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Recursos críticos
+	// (GET /analysis/critical)
+	GetCriticalVertices(ctx context.Context, request GetCriticalVerticesRequestObject) (GetCriticalVerticesResponseObject, error)
+	// Ciclos de dependência
+	// (GET /analysis/cycles)
+	GetCycles(ctx context.Context, request GetCyclesRequestObject) (GetCyclesResponseObject, error)
+	// Recursos órfãos
+	// (GET /analysis/orphans)
+	GetOrphans(ctx context.Context, request GetOrphansRequestObject) (GetOrphansResponseObject, error)
+	// Pontos únicos de falha
+	// (GET /analysis/spof)
+	GetSinglePointsOfFailure(ctx context.Context, request GetSinglePointsOfFailureRequestObject) (GetSinglePointsOfFailureResponseObject, error)
+	// Relacionamentos
+	// (GET /edges)
+	ListEdges(ctx context.Context, request ListEdgesRequestObject) (ListEdgesResponseObject, error)
+	// Criar relacionamento
+	// (POST /edges)
+	CreateEdge(ctx context.Context, request CreateEdgeRequestObject) (CreateEdgeResponseObject, error)
+	// Remover relacionamento
+	// (DELETE /edges/{key})
+	DeleteEdge(ctx context.Context, request DeleteEdgeRequestObject) (DeleteEdgeResponseObject, error)
+	// Detalhes de um relacionamento
+	// (GET /edges/{key})
+	GetEdge(ctx context.Context, request GetEdgeRequestObject) (GetEdgeResponseObject, error)
+	// Atualizar relacionamento
+	// (PUT /edges/{key})
+	UpdateEdge(ctx context.Context, request UpdateEdgeRequestObject) (UpdateEdgeResponseObject, error)
+	// Acompanhar eventos
+	// (GET /events)
+	GetEvents(ctx context.Context, request GetEventsRequestObject) (GetEventsResponseObject, error)
+	// Exportar grafo
+	// (GET /export)
+	ExportGraph(ctx context.Context, request ExportGraphRequestObject) (ExportGraphResponseObject, error)
+	// Importar grafo
+	// (POST /import)
+	ImportGraph(ctx context.Context, request ImportGraphRequestObject) (ImportGraphResponseObject, error)
+	// Políticas de propagação por classe
+	// (GET /propagation/classes)
+	ListClassPropagation(ctx context.Context, request ListClassPropagationRequestObject) (ListClassPropagationResponseObject, error)
+	// Remover política de propagação da classe
+	// (DELETE /propagation/classes/{class})
+	DeleteClassPropagation(ctx context.Context, request DeleteClassPropagationRequestObject) (DeleteClassPropagationResponseObject, error)
+	// Definir política de propagação da classe
+	// (PUT /propagation/classes/{class})
+	SetClassPropagation(ctx context.Context, request SetClassPropagationRequestObject) (SetClassPropagationResponseObject, error)
+	// Grupos de redundância
+	// (GET /redundancy-groups)
+	ListRedundancyGroups(ctx context.Context, request ListRedundancyGroupsRequestObject) (ListRedundancyGroupsResponseObject, error)
+	// Remover grupo de redundância
+	// (DELETE /redundancy-groups/{name})
+	DeleteRedundancyGroup(ctx context.Context, request DeleteRedundancyGroupRequestObject) (DeleteRedundancyGroupResponseObject, error)
+	// Grupo de redundância
+	// (GET /redundancy-groups/{name})
+	GetRedundancyGroup(ctx context.Context, request GetRedundancyGroupRequestObject) (GetRedundancyGroupResponseObject, error)
+	// Definir grupo de redundância
+	// (PUT /redundancy-groups/{name})
+	SetRedundancyGroup(ctx context.Context, request SetRedundancyGroupRequestObject) (SetRedundancyGroupResponseObject, error)
+	// Simular falhas
+	// (POST /simulate)
+	Simulate(ctx context.Context, request SimulateRequestObject) (SimulateResponseObject, error)
+	// Ordem de inicialização do grafo
+	// (GET /startup-order)
+	GetStartupOrder(ctx context.Context, request GetStartupOrderRequestObject) (GetStartupOrderResponseObject, error)
+	// Resumo da infraestrutura
+	// (GET /summary)
+	Summary(ctx context.Context, request SummaryRequestObject) (SummaryResponseObject, error)
+	// Listar recursos
+	// (GET /vertices)
+	ListVertices(ctx context.Context, request ListVerticesRequestObject) (ListVerticesResponseObject, error)
+	// Criar recurso
+	// (POST /vertices)
+	CreateVertex(ctx context.Context, request CreateVertexRequestObject) (CreateVertexResponseObject, error)
+	// Limpar status de saúde
+	// (POST /vertices/clear-health-status)
+	ClearHealthStatus(ctx context.Context, request ClearHealthStatusRequestObject) (ClearHealthStatusResponseObject, error)
+	// Remover recurso
+	// (DELETE /vertices/{key})
+	DeleteVertex(ctx context.Context, request DeleteVertexRequestObject) (DeleteVertexResponseObject, error)
+	// Detalhes de um recurso
+	// (GET /vertices/{key})
+	GetVertex(ctx context.Context, request GetVertexRequestObject) (GetVertexResponseObject, error)
+	// Atualizar recurso
+	// (PUT /vertices/{key})
+	UpdateVertex(ctx context.Context, request UpdateVertexRequestObject) (UpdateVertexResponseObject, error)
+	// Remover atributos de um recurso
+	// (DELETE /vertices/{key}/attributes)
+	DeleteVertexAttributes(ctx context.Context, request DeleteVertexAttributesRequestObject) (DeleteVertexAttributesResponseObject, error)
+	// Atributos de um recurso
+	// (GET /vertices/{key}/attributes)
+	GetVertexAttributes(ctx context.Context, request GetVertexAttributesRequestObject) (GetVertexAttributesResponseObject, error)
+	// Atualizar atributos de um recurso
+	// (PATCH /vertices/{key}/attributes)
+	UpdateVertexAttributes(ctx context.Context, request UpdateVertexAttributesRequestObject) (UpdateVertexAttributesResponseObject, error)
+	// Substituir atributos de um recurso
+	// (PUT /vertices/{key}/attributes)
+	ReplaceVertexAttributes(ctx context.Context, request ReplaceVertexAttributesRequestObject) (ReplaceVertexAttributesResponseObject, error)
+	// Dependencias de um recurso
+	// (GET /vertices/{key}/dependencies)
+	GetVertexDependencies(ctx context.Context, request GetVertexDependenciesRequestObject) (GetVertexDependenciesResponseObject, error)
+	// Recursos dependentes
+	// (GET /vertices/{key}/dependents)
+	GetVertexDependents(ctx context.Context, request GetVertexDependentsRequestObject) (GetVertexDependentsResponseObject, error)
+	// Marcar recurso como não saudável
+	// (DELETE /vertices/{key}/healthy)
+	MarkVertexUnhealthy(ctx context.Context, request MarkVertexUnhealthyRequestObject) (MarkVertexUnhealthyResponseObject, error)
+	// Marcar recurso como saudável
+	// (POST /vertices/{key}/healthy)
+	MarkVertexHealthy(ctx context.Context, request MarkVertexHealthyRequestObject) (MarkVertexHealthyResponseObject, error)
+	// Histórico de saúde
+	// (GET /vertices/{key}/history)
+	GetVertexHistory(ctx context.Context, request GetVertexHistoryRequestObject) (GetVertexHistoryResponseObject, error)
+	// Impacto
+	// (GET /vertices/{key}/impact)
+	GetVertexImpact(ctx context.Context, request GetVertexImpactRequestObject) (GetVertexImpactResponseObject, error)
+	// Vizinhos
+	// (GET /vertices/{key}/neighbors)
+	GetVertexNeighbors(ctx context.Context, request GetVertexNeighborsRequestObject) (GetVertexNeighborsResponseObject, error)
+	// Caminho entre dois recursos
+	// (GET /vertices/{key}/path/{target})
+	GetPath(ctx context.Context, request GetPathRequestObject) (GetPathResponseObject, error)
+	// Caminhos alternativos entre dois recursos
+	// (GET /vertices/{key}/paths/{target})
+	GetPaths(ctx context.Context, request GetPathsRequestObject) (GetPathsResponseObject, error)
+	// Remover política de propagação do recurso
+	// (DELETE /vertices/{key}/propagation)
+	DeleteVertexPropagation(ctx context.Context, request DeleteVertexPropagationRequestObject) (DeleteVertexPropagationResponseObject, error)
+	// Política de propagação do recurso
+	// (GET /vertices/{key}/propagation)
+	GetVertexPropagation(ctx context.Context, request GetVertexPropagationRequestObject) (GetVertexPropagationResponseObject, error)
+	// Definir política de propagação do recurso
+	// (PUT /vertices/{key}/propagation)
+	SetVertexPropagation(ctx context.Context, request SetVertexPropagationRequestObject) (SetVertexPropagationResponseObject, error)
+	// Causa raiz
+	// (GET /vertices/{key}/root-cause)
+	GetVertexRootCause(ctx context.Context, request GetVertexRootCauseRequestObject) (GetVertexRootCauseResponseObject, error)
+	// Ordem de inicialização de um recurso
+	// (GET /vertices/{key}/startup-order)
+	GetVertexStartupOrder(ctx context.Context, request GetVertexStartupOrderRequestObject) (GetVertexStartupOrderResponseObject, error)
+	// Listar webhooks
+	// (GET /webhooks)
+	ListWebhooks(ctx context.Context, request ListWebhooksRequestObject) (ListWebhooksResponseObject, error)
+	// Criar webhook
+	// (POST /webhooks)
+	CreateWebhook(ctx context.Context, request CreateWebhookRequestObject) (CreateWebhookResponseObject, error)
+	// Remover webhook
+	// (DELETE /webhooks/{id})
+	DeleteWebhook(ctx context.Context, request DeleteWebhookRequestObject) (DeleteWebhookResponseObject, error)
+	// Obter webhook
+	// (GET /webhooks/{id})
+	GetWebhook(ctx context.Context, request GetWebhookRequestObject) (GetWebhookResponseObject, error)
+	// Substituir webhook
+	// (PUT /webhooks/{id})
+	UpdateWebhook(ctx context.Context, request UpdateWebhookRequestObject) (UpdateWebhookResponseObject, error)
+	// Listar entregas
+	// (GET /webhooks/{id}/deliveries)
+	ListWebhookDeliveries(ctx context.Context, request ListWebhookDeliveriesRequestObject) (ListWebhookDeliveriesResponseObject, error)
+}
+
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// GetCriticalVertices operation middleware
+func (sh *strictHandler) GetCriticalVertices(w http.ResponseWriter, r *http.Request, params GetCriticalVerticesParams) {
+	var request GetCriticalVerticesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCriticalVertices(ctx, request.(GetCriticalVerticesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCriticalVertices")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetCriticalVerticesResponseObject); ok {
+		if err := validResponse.VisitGetCriticalVerticesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetCycles operation middleware
+func (sh *strictHandler) GetCycles(w http.ResponseWriter, r *http.Request) {
+	var request GetCyclesRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCycles(ctx, request.(GetCyclesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCycles")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetCyclesResponseObject); ok {
+		if err := validResponse.VisitGetCyclesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetOrphans operation middleware
+func (sh *strictHandler) GetOrphans(w http.ResponseWriter, r *http.Request) {
+	var request GetOrphansRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetOrphans(ctx, request.(GetOrphansRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetOrphans")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetOrphansResponseObject); ok {
+		if err := validResponse.VisitGetOrphansResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetSinglePointsOfFailure operation middleware
+func (sh *strictHandler) GetSinglePointsOfFailure(w http.ResponseWriter, r *http.Request) {
+	var request GetSinglePointsOfFailureRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetSinglePointsOfFailure(ctx, request.(GetSinglePointsOfFailureRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetSinglePointsOfFailure")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetSinglePointsOfFailureResponseObject); ok {
+		if err := validResponse.VisitGetSinglePointsOfFailureResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListEdges operation middleware
+func (sh *strictHandler) ListEdges(w http.ResponseWriter, r *http.Request) {
+	var request ListEdgesRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListEdges(ctx, request.(ListEdgesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListEdges")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListEdgesResponseObject); ok {
+		if err := validResponse.VisitListEdgesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateEdge operation middleware
+func (sh *strictHandler) CreateEdge(w http.ResponseWriter, r *http.Request) {
+	var request CreateEdgeRequestObject
+
+	var body CreateEdgeJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateEdge(ctx, request.(CreateEdgeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateEdge")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateEdgeResponseObject); ok {
+		if err := validResponse.VisitCreateEdgeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteEdge operation middleware
+func (sh *strictHandler) DeleteEdge(w http.ResponseWriter, r *http.Request, key EdgeKey) {
+	var request DeleteEdgeRequestObject
+
+	request.Key = key
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteEdge(ctx, request.(DeleteEdgeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteEdge")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteEdgeResponseObject); ok {
+		if err := validResponse.VisitDeleteEdgeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetEdge operation middleware
+func (sh *strictHandler) GetEdge(w http.ResponseWriter, r *http.Request, key EdgeKey) {
+	var request GetEdgeRequestObject
+
+	request.Key = key
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetEdge(ctx, request.(GetEdgeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetEdge")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetEdgeResponseObject); ok {
+		if err := validResponse.VisitGetEdgeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateEdge operation middleware
+func (sh *strictHandler) UpdateEdge(w http.ResponseWriter, r *http.Request, key EdgeKey) {
+	var request UpdateEdgeRequestObject
+
+	request.Key = key
+
+	var body UpdateEdgeJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateEdge(ctx, request.(UpdateEdgeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateEdge")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateEdgeResponseObject); ok {
+		if err := validResponse.VisitUpdateEdgeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetEvents operation middleware
+func (sh *strictHandler) GetEvents(w http.ResponseWriter, r *http.Request, params GetEventsParams) {
+	var request GetEventsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetEvents(ctx, request.(GetEventsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetEvents")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetEventsResponseObject); ok {
+		if err := validResponse.VisitGetEventsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ExportGraph operation middleware
+func (sh *strictHandler) ExportGraph(w http.ResponseWriter, r *http.Request, params ExportGraphParams) {
+	var request ExportGraphRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ExportGraph(ctx, request.(ExportGraphRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ExportGraph")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ExportGraphResponseObject); ok {
+		if err := validResponse.VisitExportGraphResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ImportGraph operation middleware
+func (sh *strictHandler) ImportGraph(w http.ResponseWriter, r *http.Request, params ImportGraphParams) {
+	var request ImportGraphRequestObject
+
+	request.Params = params
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "application/graphml+xml") {
+		request.Body = r.Body
+	}
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "application/json") {
+
+		var body ImportGraphJSONRequestBody
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+			return
+		}
+		request.JSONBody = &body
+	}
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/form-data") {
+		if reader, err := r.MultipartReader(); err != nil {
+			sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode multipart body: %w", err))
+			return
+		} else {
+			request.MultipartBody = reader
+		}
+	}
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ImportGraph(ctx, request.(ImportGraphRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ImportGraph")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ImportGraphResponseObject); ok {
+		if err := validResponse.VisitImportGraphResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListClassPropagation operation middleware
+func (sh *strictHandler) ListClassPropagation(w http.ResponseWriter, r *http.Request) {
+	var request ListClassPropagationRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListClassPropagation(ctx, request.(ListClassPropagationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListClassPropagation")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListClassPropagationResponseObject); ok {
+		if err := validResponse.VisitListClassPropagationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteClassPropagation operation middleware
+func (sh *strictHandler) DeleteClassPropagation(w http.ResponseWriter, r *http.Request, class string) {
+	var request DeleteClassPropagationRequestObject
+
+	request.Class = class
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteClassPropagation(ctx, request.(DeleteClassPropagationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteClassPropagation")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteClassPropagationResponseObject); ok {
+		if err := validResponse.VisitDeleteClassPropagationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// SetClassPropagation operation middleware
+func (sh *strictHandler) SetClassPropagation(w http.ResponseWriter, r *http.Request, class string) {
+	var request SetClassPropagationRequestObject
+
+	request.Class = class
+
+	var body SetClassPropagationJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SetClassPropagation(ctx, request.(SetClassPropagationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SetClassPropagation")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SetClassPropagationResponseObject); ok {
+		if err := validResponse.VisitSetClassPropagationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListRedundancyGroups operation middleware
+func (sh *strictHandler) ListRedundancyGroups(w http.ResponseWriter, r *http.Request) {
+	var request ListRedundancyGroupsRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListRedundancyGroups(ctx, request.(ListRedundancyGroupsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListRedundancyGroups")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListRedundancyGroupsResponseObject); ok {
+		if err := validResponse.VisitListRedundancyGroupsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteRedundancyGroup operation middleware
+func (sh *strictHandler) DeleteRedundancyGroup(w http.ResponseWriter, r *http.Request, name string) {
+	var request DeleteRedundancyGroupRequestObject
+
+	request.Name = name
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteRedundancyGroup(ctx, request.(DeleteRedundancyGroupRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteRedundancyGroup")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteRedundancyGroupResponseObject); ok {
+		if err := validResponse.VisitDeleteRedundancyGroupResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetRedundancyGroup operation middleware
+func (sh *strictHandler) GetRedundancyGroup(w http.ResponseWriter, r *http.Request, name string) {
+	var request GetRedundancyGroupRequestObject
+
+	request.Name = name
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetRedundancyGroup(ctx, request.(GetRedundancyGroupRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetRedundancyGroup")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetRedundancyGroupResponseObject); ok {
+		if err := validResponse.VisitGetRedundancyGroupResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// SetRedundancyGroup operation middleware
+func (sh *strictHandler) SetRedundancyGroup(w http.ResponseWriter, r *http.Request, name string) {
+	var request SetRedundancyGroupRequestObject
+
+	request.Name = name
+
+	var body SetRedundancyGroupJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SetRedundancyGroup(ctx, request.(SetRedundancyGroupRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SetRedundancyGroup")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SetRedundancyGroupResponseObject); ok {
+		if err := validResponse.VisitSetRedundancyGroupResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// Simulate operation middleware
+func (sh *strictHandler) Simulate(w http.ResponseWriter, r *http.Request) {
+	var request SimulateRequestObject
+
+	var body SimulateJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.Simulate(ctx, request.(SimulateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "Simulate")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SimulateResponseObject); ok {
+		if err := validResponse.VisitSimulateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetStartupOrder operation middleware
+func (sh *strictHandler) GetStartupOrder(w http.ResponseWriter, r *http.Request) {
+	var request GetStartupOrderRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetStartupOrder(ctx, request.(GetStartupOrderRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetStartupOrder")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetStartupOrderResponseObject); ok {
+		if err := validResponse.VisitGetStartupOrderResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// Summary operation middleware
+func (sh *strictHandler) Summary(w http.ResponseWriter, r *http.Request, params SummaryParams) {
+	var request SummaryRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.Summary(ctx, request.(SummaryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "Summary")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SummaryResponseObject); ok {
+		if err := validResponse.VisitSummaryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListVertices operation middleware
+func (sh *strictHandler) ListVertices(w http.ResponseWriter, r *http.Request, params ListVerticesParams) {
+	var request ListVerticesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListVertices(ctx, request.(ListVerticesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListVertices")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListVerticesResponseObject); ok {
+		if err := validResponse.VisitListVerticesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateVertex operation middleware
+func (sh *strictHandler) CreateVertex(w http.ResponseWriter, r *http.Request) {
+	var request CreateVertexRequestObject
+
+	var body CreateVertexJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateVertex(ctx, request.(CreateVertexRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateVertex")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateVertexResponseObject); ok {
+		if err := validResponse.VisitCreateVertexResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ClearHealthStatus operation middleware
+func (sh *strictHandler) ClearHealthStatus(w http.ResponseWriter, r *http.Request) {
+	var request ClearHealthStatusRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ClearHealthStatus(ctx, request.(ClearHealthStatusRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ClearHealthStatus")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ClearHealthStatusResponseObject); ok {
+		if err := validResponse.VisitClearHealthStatusResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteVertex operation middleware
+func (sh *strictHandler) DeleteVertex(w http.ResponseWriter, r *http.Request, key Key) {
+	var request DeleteVertexRequestObject
+
+	request.Key = key
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteVertex(ctx, request.(DeleteVertexRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteVertex")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteVertexResponseObject); ok {
+		if err := validResponse.VisitDeleteVertexResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetVertex operation middleware
+func (sh *strictHandler) GetVertex(w http.ResponseWriter, r *http.Request, key Key) {
+	var request GetVertexRequestObject
+
+	request.Key = key
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetVertex(ctx, request.(GetVertexRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetVertex")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetVertexResponseObject); ok {
+		if err := validResponse.VisitGetVertexResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateVertex operation middleware
+func (sh *strictHandler) UpdateVertex(w http.ResponseWriter, r *http.Request, key Key) {
+	var request UpdateVertexRequestObject
+
+	request.Key = key
+
+	var body UpdateVertexJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateVertex(ctx, request.(UpdateVertexRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateVertex")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateVertexResponseObject); ok {
+		if err := validResponse.VisitUpdateVertexResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteVertexAttributes operation middleware
+func (sh *strictHandler) DeleteVertexAttributes(w http.ResponseWriter, r *http.Request, key Key, params DeleteVertexAttributesParams) {
+	var request DeleteVertexAttributesRequestObject
 
-type MarkVertexHealthy200Response struct {
-}
+	request.Key = key
+	request.Params = params
 
-func (response MarkVertexHealthy200Response) VisitMarkVertexHealthyResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteVertexAttributes(ctx, request.(DeleteVertexAttributesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteVertexAttributes")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteVertexAttributesResponseObject); ok {
+		if err := validResponse.VisitDeleteVertexAttributesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type MarkVertexHealthy401JSONResponse struct{ UnauthorizedJSONResponse }
+// GetVertexAttributes operation middleware
+func (sh *strictHandler) GetVertexAttributes(w http.ResponseWriter, r *http.Request, key Key) {
+	var request GetVertexAttributesRequestObject
 
-func (response MarkVertexHealthy401JSONResponse) VisitMarkVertexHealthyResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	request.Key = key
 
-	return json.NewEncoder(w).Encode(response)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetVertexAttributes(ctx, request.(GetVertexAttributesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetVertexAttributes")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetVertexAttributesResponseObject); ok {
+		if err := validResponse.VisitGetVertexAttributesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type MarkVertexHealthy404JSONResponse struct{ NotFoundJSONResponse }
+// UpdateVertexAttributes operation middleware
+func (sh *strictHandler) UpdateVertexAttributes(w http.ResponseWriter, r *http.Request, key Key) {
+	var request UpdateVertexAttributesRequestObject
 
-func (response MarkVertexHealthy404JSONResponse) VisitMarkVertexHealthyResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	request.Key = key
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body UpdateVertexAttributesJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type MarkVertexHealthy422JSONResponse struct{ InvalidRequestJSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateVertexAttributes(ctx, request.(UpdateVertexAttributesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateVertexAttributes")
+	}
 
-func (response MarkVertexHealthy422JSONResponse) VisitMarkVertexHealthyResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(422)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateVertexAttributesResponseObject); ok {
+		if err := validResponse.VisitUpdateVertexAttributesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type MarkVertexHealthy500JSONResponse struct {
-	InternalServerErrorJSONResponse
-}
+// ReplaceVertexAttributes operation middleware
+func (sh *strictHandler) ReplaceVertexAttributes(w http.ResponseWriter, r *http.Request, key Key) {
+	var request ReplaceVertexAttributesRequestObject
 
-func (response MarkVertexHealthy500JSONResponse) VisitMarkVertexHealthyResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.Key = key
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body ReplaceVertexAttributesJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type GetVertexNeighborsRequestObject struct {
-	Key Key `json:"key"`
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ReplaceVertexAttributes(ctx, request.(ReplaceVertexAttributesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ReplaceVertexAttributes")
+	}
 
-type GetVertexNeighborsResponseObject interface {
-	VisitGetVertexNeighborsResponse(w http.ResponseWriter) error
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ReplaceVertexAttributesResponseObject); ok {
+		if err := validResponse.VisitReplaceVertexAttributesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetVertexNeighbors200JSONResponse Subgraph
+// GetVertexDependencies operation middleware
+func (sh *strictHandler) GetVertexDependencies(w http.ResponseWriter, r *http.Request, key Key, params GetVertexDependenciesParams) {
+	var request GetVertexDependenciesRequestObject
 
-func (response GetVertexNeighbors200JSONResponse) VisitGetVertexNeighborsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	request.Key = key
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetVertexDependencies(ctx, request.(GetVertexDependenciesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetVertexDependencies")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetVertexDependenciesResponseObject); ok {
+		if err := validResponse.VisitGetVertexDependenciesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetVertexNeighbors401JSONResponse struct{ UnauthorizedJSONResponse }
+// GetVertexDependents operation middleware
+func (sh *strictHandler) GetVertexDependents(w http.ResponseWriter, r *http.Request, key Key, params GetVertexDependentsParams) {
+	var request GetVertexDependentsRequestObject
 
-func (response GetVertexNeighbors401JSONResponse) VisitGetVertexNeighborsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	request.Key = key
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetVertexDependents(ctx, request.(GetVertexDependentsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetVertexDependents")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetVertexDependentsResponseObject); ok {
+		if err := validResponse.VisitGetVertexDependentsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetVertexNeighbors404JSONResponse struct{ NotFoundJSONResponse }
+// MarkVertexUnhealthy operation middleware
+func (sh *strictHandler) MarkVertexUnhealthy(w http.ResponseWriter, r *http.Request, key Key, params MarkVertexUnhealthyParams) {
+	var request MarkVertexUnhealthyRequestObject
 
-func (response GetVertexNeighbors404JSONResponse) VisitGetVertexNeighborsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	request.Key = key
+	request.Params = params
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "application/json") {
 
-	return json.NewEncoder(w).Encode(response)
-}
+		var body MarkVertexUnhealthyJSONRequestBody
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+			return
+		}
+		request.JSONBody = &body
+	}
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "text/plain") {
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't read body: %w", err))
+			return
+		}
+		body := MarkVertexUnhealthyTextRequestBody(data)
+		request.TextBody = &body
+	}
 
-type GetVertexNeighbors422JSONResponse struct{ InvalidRequestJSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.MarkVertexUnhealthy(ctx, request.(MarkVertexUnhealthyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "MarkVertexUnhealthy")
+	}
 
-func (response GetVertexNeighbors422JSONResponse) VisitGetVertexNeighborsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(422)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(MarkVertexUnhealthyResponseObject); ok {
+		if err := validResponse.VisitMarkVertexUnhealthyResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetVertexNeighbors500JSONResponse struct {
-	InternalServerErrorJSONResponse
-}
+// MarkVertexHealthy operation middleware
+func (sh *strictHandler) MarkVertexHealthy(w http.ResponseWriter, r *http.Request, key Key, params MarkVertexHealthyParams) {
+	var request MarkVertexHealthyRequestObject
 
-func (response GetVertexNeighbors500JSONResponse) VisitGetVertexNeighborsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.Key = key
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.MarkVertexHealthy(ctx, request.(MarkVertexHealthyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "MarkVertexHealthy")
+	}
 
-type GetPathRequestObject struct {
-	Key    Key    `json:"key"`
-	Target string `json:"target"`
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(MarkVertexHealthyResponseObject); ok {
+		if err := validResponse.VisitMarkVertexHealthyResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetPathResponseObject interface {
-	VisitGetPathResponse(w http.ResponseWriter) error
+// GetVertexHistory operation middleware
+func (sh *strictHandler) GetVertexHistory(w http.ResponseWriter, r *http.Request, key Key, params GetVertexHistoryParams) {
+	var request GetVertexHistoryRequestObject
+
+	request.Key = key
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetVertexHistory(ctx, request.(GetVertexHistoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetVertexHistory")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetVertexHistoryResponseObject); ok {
+		if err := validResponse.VisitGetVertexHistoryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetPath200JSONResponse Subgraph
+// GetVertexImpact operation middleware
+func (sh *strictHandler) GetVertexImpact(w http.ResponseWriter, r *http.Request, key Key, params GetVertexImpactParams) {
+	var request GetVertexImpactRequestObject
+
+	request.Key = key
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetVertexImpact(ctx, request.(GetVertexImpactRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetVertexImpact")
+	}
 
-func (response GetPath200JSONResponse) VisitGetPathResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetVertexImpactResponseObject); ok {
+		if err := validResponse.VisitGetVertexImpactResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetPath401JSONResponse struct{ UnauthorizedJSONResponse }
-
-func (response GetPath401JSONResponse) VisitGetPathResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+// GetVertexNeighbors operation middleware
+func (sh *strictHandler) GetVertexNeighbors(w http.ResponseWriter, r *http.Request, key Key, params GetVertexNeighborsParams) {
+	var request GetVertexNeighborsRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Key = key
+	request.Params = params
 
-type GetPath404JSONResponse struct{ NotFoundJSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetVertexNeighbors(ctx, request.(GetVertexNeighborsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetVertexNeighbors")
+	}
 
-func (response GetPath404JSONResponse) VisitGetPathResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetVertexNeighborsResponseObject); ok {
+		if err := validResponse.VisitGetVertexNeighborsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetPath422JSONResponse struct{ InvalidRequestJSONResponse }
-
-func (response GetPath422JSONResponse) VisitGetPathResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(422)
+// GetPath operation middleware
+func (sh *strictHandler) GetPath(w http.ResponseWriter, r *http.Request, key Key, target string, params GetPathParams) {
+	var request GetPathRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Key = key
+	request.Target = target
+	request.Params = params
 
-type GetPath500JSONResponse struct {
-	InternalServerErrorJSONResponse
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPath(ctx, request.(GetPathRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPath")
+	}
 
-func (response GetPath500JSONResponse) VisitGetPathResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetPathResponseObject); ok {
+		if err := validResponse.VisitGetPathResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// Resumo da infraestrutura
-	// (GET /summary)
-	Summary(ctx context.Context, request SummaryRequestObject) (SummaryResponseObject, error)
-	// Limpar status de saúde
-	// (POST /vertices/clear-health-status)
-	ClearHealthStatus(ctx context.Context, request ClearHealthStatusRequestObject) (ClearHealthStatusResponseObject, error)
-	// Detalhes de um recurso
-	// (GET /vertices/{key})
-	GetVertex(ctx context.Context, request GetVertexRequestObject) (GetVertexResponseObject, error)
-	// Atributos de um recurso
-	// (GET /vertices/{key}/attributes)
-	GetVertexAttributes(ctx context.Context, request GetVertexAttributesRequestObject) (GetVertexAttributesResponseObject, error)
-	// Dependencias de um recurso
-	// (GET /vertices/{key}/dependencies)
-	GetVertexDependencies(ctx context.Context, request GetVertexDependenciesRequestObject) (GetVertexDependenciesResponseObject, error)
-	// Recursos dependentes
-	// (GET /vertices/{key}/dependents)
-	GetVertexDependents(ctx context.Context, request GetVertexDependentsRequestObject) (GetVertexDependentsResponseObject, error)
-	// Marcar recurso como não saudável
-	// (DELETE /vertices/{key}/healthy)
-	MarkVertexUnhealthy(ctx context.Context, request MarkVertexUnhealthyRequestObject) (MarkVertexUnhealthyResponseObject, error)
-	// Marcar recurso como saudável
-	// (POST /vertices/{key}/healthy)
-	MarkVertexHealthy(ctx context.Context, request MarkVertexHealthyRequestObject) (MarkVertexHealthyResponseObject, error)
-	// Vizinhos
-	// (GET /vertices/{key}/neighbors)
-	GetVertexNeighbors(ctx context.Context, request GetVertexNeighborsRequestObject) (GetVertexNeighborsResponseObject, error)
-	// Caminho entre dois recursos
-	// (GET /vertices/{key}/path/{target})
-	GetPath(ctx context.Context, request GetPathRequestObject) (GetPathResponseObject, error)
-}
+// GetPaths operation middleware
+func (sh *strictHandler) GetPaths(w http.ResponseWriter, r *http.Request, key Key, target string, params GetPathsParams) {
+	var request GetPathsRequestObject
 
-type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
-type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+	request.Key = key
+	request.Target = target
+	request.Params = params
 
-type StrictHTTPServerOptions struct {
-	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
-	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPaths(ctx, request.(GetPathsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPaths")
+	}
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
-		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		},
-		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		},
-	}}
-}
+	response, err := handler(r.Context(), w, r, request)
 
-func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetPathsResponseObject); ok {
+		if err := validResponse.VisitGetPathsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-	options     StrictHTTPServerOptions
-}
+// DeleteVertexPropagation operation middleware
+func (sh *strictHandler) DeleteVertexPropagation(w http.ResponseWriter, r *http.Request, key Key) {
+	var request DeleteVertexPropagationRequestObject
 
-// Summary operation middleware
-func (sh *strictHandler) Summary(w http.ResponseWriter, r *http.Request) {
-	var request SummaryRequestObject
+	request.Key = key
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.Summary(ctx, request.(SummaryRequestObject))
+		return sh.ssi.DeleteVertexPropagation(ctx, request.(DeleteVertexPropagationRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "Summary")
+		handler = middleware(handler, "DeleteVertexPropagation")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(SummaryResponseObject); ok {
-		if err := validResponse.VisitSummaryResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteVertexPropagationResponseObject); ok {
+		if err := validResponse.VisitDeleteVertexPropagationResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1387,23 +8237,25 @@ func (sh *strictHandler) Summary(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// ClearHealthStatus operation middleware
-func (sh *strictHandler) ClearHealthStatus(w http.ResponseWriter, r *http.Request) {
-	var request ClearHealthStatusRequestObject
+// GetVertexPropagation operation middleware
+func (sh *strictHandler) GetVertexPropagation(w http.ResponseWriter, r *http.Request, key Key) {
+	var request GetVertexPropagationRequestObject
+
+	request.Key = key
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ClearHealthStatus(ctx, request.(ClearHealthStatusRequestObject))
+		return sh.ssi.GetVertexPropagation(ctx, request.(GetVertexPropagationRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ClearHealthStatus")
+		handler = middleware(handler, "GetVertexPropagation")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ClearHealthStatusResponseObject); ok {
-		if err := validResponse.VisitClearHealthStatusResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetVertexPropagationResponseObject); ok {
+		if err := validResponse.VisitGetVertexPropagationResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1411,25 +8263,32 @@ func (sh *strictHandler) ClearHealthStatus(w http.ResponseWriter, r *http.Reques
 	}
 }
 
-// GetVertex operation middleware
-func (sh *strictHandler) GetVertex(w http.ResponseWriter, r *http.Request, key Key) {
-	var request GetVertexRequestObject
+// SetVertexPropagation operation middleware
+func (sh *strictHandler) SetVertexPropagation(w http.ResponseWriter, r *http.Request, key Key) {
+	var request SetVertexPropagationRequestObject
 
 	request.Key = key
 
+	var body SetVertexPropagationJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetVertex(ctx, request.(GetVertexRequestObject))
+		return sh.ssi.SetVertexPropagation(ctx, request.(SetVertexPropagationRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetVertex")
+		handler = middleware(handler, "SetVertexPropagation")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetVertexResponseObject); ok {
-		if err := validResponse.VisitGetVertexResponse(w); err != nil {
+	} else if validResponse, ok := response.(SetVertexPropagationResponseObject); ok {
+		if err := validResponse.VisitSetVertexPropagationResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1437,25 +8296,25 @@ func (sh *strictHandler) GetVertex(w http.ResponseWriter, r *http.Request, key K
 	}
 }
 
-// GetVertexAttributes operation middleware
-func (sh *strictHandler) GetVertexAttributes(w http.ResponseWriter, r *http.Request, key Key) {
-	var request GetVertexAttributesRequestObject
+// GetVertexRootCause operation middleware
+func (sh *strictHandler) GetVertexRootCause(w http.ResponseWriter, r *http.Request, key Key) {
+	var request GetVertexRootCauseRequestObject
 
 	request.Key = key
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetVertexAttributes(ctx, request.(GetVertexAttributesRequestObject))
+		return sh.ssi.GetVertexRootCause(ctx, request.(GetVertexRootCauseRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetVertexAttributes")
+		handler = middleware(handler, "GetVertexRootCause")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetVertexAttributesResponseObject); ok {
-		if err := validResponse.VisitGetVertexAttributesResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetVertexRootCauseResponseObject); ok {
+		if err := validResponse.VisitGetVertexRootCauseResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1463,26 +8322,26 @@ func (sh *strictHandler) GetVertexAttributes(w http.ResponseWriter, r *http.Requ
 	}
 }
 
-// GetVertexDependencies operation middleware
-func (sh *strictHandler) GetVertexDependencies(w http.ResponseWriter, r *http.Request, key Key, params GetVertexDependenciesParams) {
-	var request GetVertexDependenciesRequestObject
+// GetVertexStartupOrder operation middleware
+func (sh *strictHandler) GetVertexStartupOrder(w http.ResponseWriter, r *http.Request, key Key, params GetVertexStartupOrderParams) {
+	var request GetVertexStartupOrderRequestObject
 
 	request.Key = key
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetVertexDependencies(ctx, request.(GetVertexDependenciesRequestObject))
+		return sh.ssi.GetVertexStartupOrder(ctx, request.(GetVertexStartupOrderRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetVertexDependencies")
+		handler = middleware(handler, "GetVertexStartupOrder")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetVertexDependenciesResponseObject); ok {
-		if err := validResponse.VisitGetVertexDependenciesResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetVertexStartupOrderResponseObject); ok {
+		if err := validResponse.VisitGetVertexStartupOrderResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1490,26 +8349,54 @@ func (sh *strictHandler) GetVertexDependencies(w http.ResponseWriter, r *http.Re
 	}
 }
 
-// GetVertexDependents operation middleware
-func (sh *strictHandler) GetVertexDependents(w http.ResponseWriter, r *http.Request, key Key, params GetVertexDependentsParams) {
-	var request GetVertexDependentsRequestObject
+// ListWebhooks operation middleware
+func (sh *strictHandler) ListWebhooks(w http.ResponseWriter, r *http.Request) {
+	var request ListWebhooksRequestObject
 
-	request.Key = key
-	request.Params = params
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListWebhooks(ctx, request.(ListWebhooksRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListWebhooks")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListWebhooksResponseObject); ok {
+		if err := validResponse.VisitListWebhooksResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateWebhook operation middleware
+func (sh *strictHandler) CreateWebhook(w http.ResponseWriter, r *http.Request) {
+	var request CreateWebhookRequestObject
+
+	var body CreateWebhookJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetVertexDependents(ctx, request.(GetVertexDependentsRequestObject))
+		return sh.ssi.CreateWebhook(ctx, request.(CreateWebhookRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetVertexDependents")
+		handler = middleware(handler, "CreateWebhook")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetVertexDependentsResponseObject); ok {
-		if err := validResponse.VisitGetVertexDependentsResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateWebhookResponseObject); ok {
+		if err := validResponse.VisitCreateWebhookResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1517,25 +8404,25 @@ func (sh *strictHandler) GetVertexDependents(w http.ResponseWriter, r *http.Requ
 	}
 }
 
-// MarkVertexUnhealthy operation middleware
-func (sh *strictHandler) MarkVertexUnhealthy(w http.ResponseWriter, r *http.Request, key Key) {
-	var request MarkVertexUnhealthyRequestObject
+// DeleteWebhook operation middleware
+func (sh *strictHandler) DeleteWebhook(w http.ResponseWriter, r *http.Request, id WebhookId) {
+	var request DeleteWebhookRequestObject
 
-	request.Key = key
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.MarkVertexUnhealthy(ctx, request.(MarkVertexUnhealthyRequestObject))
+		return sh.ssi.DeleteWebhook(ctx, request.(DeleteWebhookRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "MarkVertexUnhealthy")
+		handler = middleware(handler, "DeleteWebhook")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(MarkVertexUnhealthyResponseObject); ok {
-		if err := validResponse.VisitMarkVertexUnhealthyResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteWebhookResponseObject); ok {
+		if err := validResponse.VisitDeleteWebhookResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1543,25 +8430,25 @@ func (sh *strictHandler) MarkVertexUnhealthy(w http.ResponseWriter, r *http.Requ
 	}
 }
 
-// MarkVertexHealthy operation middleware
-func (sh *strictHandler) MarkVertexHealthy(w http.ResponseWriter, r *http.Request, key Key) {
-	var request MarkVertexHealthyRequestObject
+// GetWebhook operation middleware
+func (sh *strictHandler) GetWebhook(w http.ResponseWriter, r *http.Request, id WebhookId) {
+	var request GetWebhookRequestObject
 
-	request.Key = key
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.MarkVertexHealthy(ctx, request.(MarkVertexHealthyRequestObject))
+		return sh.ssi.GetWebhook(ctx, request.(GetWebhookRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "MarkVertexHealthy")
+		handler = middleware(handler, "GetWebhook")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(MarkVertexHealthyResponseObject); ok {
-		if err := validResponse.VisitMarkVertexHealthyResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetWebhookResponseObject); ok {
+		if err := validResponse.VisitGetWebhookResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1569,25 +8456,32 @@ func (sh *strictHandler) MarkVertexHealthy(w http.ResponseWriter, r *http.Reques
 	}
 }
 
-// GetVertexNeighbors operation middleware
-func (sh *strictHandler) GetVertexNeighbors(w http.ResponseWriter, r *http.Request, key Key) {
-	var request GetVertexNeighborsRequestObject
+// UpdateWebhook operation middleware
+func (sh *strictHandler) UpdateWebhook(w http.ResponseWriter, r *http.Request, id WebhookId) {
+	var request UpdateWebhookRequestObject
 
-	request.Key = key
+	request.Id = id
+
+	var body UpdateWebhookJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetVertexNeighbors(ctx, request.(GetVertexNeighborsRequestObject))
+		return sh.ssi.UpdateWebhook(ctx, request.(UpdateWebhookRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetVertexNeighbors")
+		handler = middleware(handler, "UpdateWebhook")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetVertexNeighborsResponseObject); ok {
-		if err := validResponse.VisitGetVertexNeighborsResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateWebhookResponseObject); ok {
+		if err := validResponse.VisitUpdateWebhookResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1595,26 +8489,25 @@ func (sh *strictHandler) GetVertexNeighbors(w http.ResponseWriter, r *http.Reque
 	}
 }
 
-// GetPath operation middleware
-func (sh *strictHandler) GetPath(w http.ResponseWriter, r *http.Request, key Key, target string) {
-	var request GetPathRequestObject
+// ListWebhookDeliveries operation middleware
+func (sh *strictHandler) ListWebhookDeliveries(w http.ResponseWriter, r *http.Request, id WebhookId) {
+	var request ListWebhookDeliveriesRequestObject
 
-	request.Key = key
-	request.Target = target
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetPath(ctx, request.(GetPathRequestObject))
+		return sh.ssi.ListWebhookDeliveries(ctx, request.(ListWebhookDeliveriesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetPath")
+		handler = middleware(handler, "ListWebhookDeliveries")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetPathResponseObject); ok {
-		if err := validResponse.VisitGetPathResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListWebhookDeliveriesResponseObject); ok {
+		if err := validResponse.VisitListWebhookDeliveriesResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1625,81 +8518,269 @@ func (sh *strictHandler) GetPath(w http.ResponseWriter, r *http.Request, key Key
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+RczW4cR5J+lUR5D3aj2E1SHozRe5ilSFnmzOhnTdkDrEnY0VXB7pSqMkv5Q5MmCOxD",
-	"7AsIezA0gE7GXnTtN9knWURk1m8XSYkjw7PWSezurMzIiC+++MksXSaZLiutUDmbzC+TCgyU6NDwpxd4",
-	"Qf/kaDMjKye1SubJYY7KyVOZQa6NWL9VMtMi18Jg5o3VSZrgOZRVgck8Obi/e/SXgz/fS9JE0rMVuFWS",
-	"JgpK+pWmTxODL700mCdzZzymic1WWAKt6y4qGmadkWqZXF1d0WBbaWWRxTtUDo2C4gjNGZoHxmhDX2da",
-	"OVSO/oSqKmQGJPnsuSXxL1vpaGSOyfwP29tpguHpZk4RJhVh1quuWJXRFRongxBhjqGS9te/5HLJeqGZ",
-	"k7TejFQOl8gzYi1w/9FHqCwssRQ5Dp5tFNFV2ndBgnq6k2a0XjzHzAW19VegPQnJG2UJLZozmYdtHqoz",
-	"KGT+Nb70aN1d1Pn57m6rzvuQi3qu35cSaVfSyvXP6/8mZZ6tXxUyBxLpsXZfaq/yOylv+/NWeY+1E2Gm",
-	"35vqmCmEItWhIh0ZyDXJ9I0C71bayJ/wjvrbafXXm+x36MI5CvCO9gcMQ542bJL39SBfjuzrm1IYLCCT",
-	"mmhYOTKBM1jzt03SoXoKsHZEP/Q1Cu3FPjhcaiMhhIHu3G00sLTPU2nwRyiK7zOtVJImOThYgMX68xIc",
-	"/ggX4ePJhtbS941IN4hycH/38dHhN3tPto799vY9fHS0dbT3l60nfzv89yRNOp/i7834UbkKWGCxKdlj",
-	"XeKmJOKlRyLd9SuB53Ih86Fs+1rhOTlHpkvxZdRZkva/vw8qYwwcQK7tqFRWe5ONQOCvJO1m6KbJtJFL",
-	"LK/TVV8zo2s6MEt077VmjtZJpe+86MCZQloRLJJG9Da6aASkaaTjLOXrIU4G7pcmR36xNFCtNrcVucwK",
-	"sqi3A0tbAZVBi8qRjWivp9qUINZvnSwEJVsi08r6UgsUZ9J6KGpnnm74IWFgY/0jFGdocshRGp0Kg04b",
-	"BcJpWk9bIR0qS/peGjjVqSjRlgGAgX2tw+dQkhSYRSGlQcfiI8lQK2mvKGiy0rb6WWhdICgmwnyJIxzx",
-	"V2kd0LaHaiEBcjzDkvyg9gIrVJST8kVea36Z/IvB02SefDJrM9VZJLkZM9zVdZbsiArGwAWNXMnlqpDL",
-	"lRuR9kGBo+IRPiGDuwj4LRqH510RD2gySohGhKuMVJmsoHjvaUeJZ4NmAuJAWLSWrJ+3u+l6XoPpHCtU",
-	"RK7I2D1uffI4SdLkgH9e/11lEhhjj2RmNGeT65+1OO667XEyyhZnBO/sFuhEaT4QZjZN8nUb+foWGRBL",
-	"GN81U5oEYu6gqrOn2i06VHOEyxACchQPo9QjbFOWYC5GQ7f15fqVkVpYvTAo2IMh1yIHIdWpAbTOeOcN",
-	"bNCH0w6K769x1CdCrd+WaLTgYWMuG4kMrVAgKGh3K72d3e172+02n10zyWgSFQS7HgmjskVM3CLUvRGR",
-	"mBFHBfFqhVC41cX37wNL5lELPl+/OkNp/2EY9icsbgVlx6wbuhzdUy/ycezJdQAjq2eIog14RsnH0AlO",
-	"nvEszw7fM43MdXh4QEWWq/AkTYz2jv944RdoFDq032eFt/TlGLHEXY8ETFi/zbGTgUwFZ8RB95XO28gI",
-	"pjWDqLQRGXjLxvec2lsPNUdGEpz2xT+FwmLqjMeTsaA5mszKd22vvHdmdlOOeltGGrsh2ztJ2vy92/n7",
-	"3jUrWvd9tsLsxZhXO1mSksuKyGv9tnCyBEpmePOhoOkLsbu9+4et7T9u7e482/nj/N7ufPeL/0jS8PXO",
-	"Ln+9W399kiacarlkzlXGFq2W3C11rLHU29DJhuNe7yt7zhm/8O5GQgFn5MIT0/as3ZBJ35t6kwznPOBP",
-	"oTXBjhVmHqjzUOUyA6GFdeC8FeA8h7QDPJUKBYjKSG0k5ZZcyJ5j5oNZRvN+/mIoyTNZ3SRCfDxtvCJN",
-	"CqlejKcKUPiRFb6FQpvuEimDmR2Z8oQwQdrEEO1FXIyE0QqfnCbz7zaajenlZoC43PDhkw02pgH9Yr2W",
-	"/GQEHjWCNkEwRvuWQCHdxRGFkACDBYJB85Vz1Z53K2KO0CFpf/uy9oI//+1ZMmwj3OchwukXqIS3Ui0F",
-	"iDCQ4xQ2Y1p5Vs5VoSWB56FXeqCzEWA/lG7lFxSCTBEfs/PZbMlfTzNdznRlS6lyzGe2woz0IdWprts+",
-	"kHEViSVIet6ByaSVemrBWjDwb7kupZKaZpouTNtVfhYHii/FURiabLZPSN17Tw/FqZYiMxJyCLnxqTYK",
-	"MzShLCscWGHWryqZA1V4VBpxNkMfQwLGQcuGkCcys37jZEZ5CU1G/kpE9j8hfa6MzoMDTcWDAmKc9wvr",
-	"pPNSWF34OBhJH5JrQVop06UW+48O7ttUWGkd5Q80Ybl+7YzMwKai0EtL0HYGMoY7DSGHDg97qvDI60Ly",
-	"qRcUV0NiVlDJHwpUAZyv1UIMQtuxOlaffCL2tcpQEk0t1q8sbfZYNeq0yEmYBIGl8CWIJpHgOp9LqGwF",
-	"Jek7FqTsrGhK6VCUOscCTE8K7k2Rx9CSPCvZ5rlXTkeRPqEAzrOJ9Wvep7aOQrmZ04AtMZl8u34dkp/J",
-	"RHyqvVDrX+xnc/E11tV5SQsx1dk0qKxuitMXZbeuYT0vQGXB7LypaVhmz1BAs5NJf+rN7VhxVksUl8v7",
-	"tRTllzk31yDTVVHn4KdesdFkMMdjKt9rSKRiWAKQOoJK8xYHQVEtkrVAAdn6TVZQovHpwd7Dzxq1HbSj",
-	"aE97trsVt/47W8Oy5nQqJMcTlbPqKVyUVRFaDcecJ3BRuFcXlbTH5tv7x0mtwloUWvBJlFaFnpdy69el",
-	"yGRWaPuvpBuLzyElGdT6lzCIaT+uYGIrg0aSbE1bg3M3GVoh04Cgp9qwpLyebRVi/3Ss9nlBwl78dSNL",
-	"Fv/7n/8ltAqJYdvYivu8XQh+nkQvRYFnYASIycSuXxvJJY5eFOzyzCfR/gJFsf5lSSJOJgFD82i0DpJE",
-	"Jk3mCzDzyUQcaMnYLenxpnyhZKsUpXe+kasp+lPB3SQyqSSvsus3lArnlCBIww6uDUmdFRBcXCoZiYuJ",
-	"LhU52kIuY9+T4Oy6Pa4aZrAkjyTqZP81ukTCFEkdtZ9TVkiEXAZNnklYSJqoFCBArV8V0rJOZVlB5vSU",
-	"TRpBmBIxaTEGQi3up7Gz0Hyzv/HNXkqeSwB76bmKLJil3fpVqAlIQ6SNUyhW8Kewq6dGV7CE+oCIVBZi",
-	"fKjYLW3uQdkSOuOnScY+ZX+dNOXb5DPaQxXmjDTSs5SPnhFcYIkGjJhMqr4QwXI5TCZsi3KxfrP03LYP",
-	"Qk0bCBX6gjBWyQoLqZC7LwvTkRvKhWw6Q/uHs/2DdEBi0YfARmizpowotK5srRBtU7IY5pJUqAWcgSIL",
-	"5SgWXha5DdZ2uGwiKXinS3AhNk6PFdvZWrSi1ByMiQgfGqhW4kmIcwQUd8EBajIJNBECzmQigMN/0I/2",
-	"wjS0HbNnjHzTtJmmDStZXHpKk3sxzpekv72H4lMmT4e52MsuSA9Bps/IW5lpwAC5W4dRF3xiFCxEyj+F",
-	"jCRv8U2pgDqVodEg8n6EFi32OVAfRHRkF+Io0xXldkjR2+G529r7EQyKQx4v9hQUF1ZSIB/YdUBzIYc4",
-	"hzQGWl/yVkou3irSUhskaSwYJ4sV76oHjJAmQR376lMhmw7jqqB8ogCVIXAoZnuAWcJUPLBBH7Gxgm1w",
-	"LMOsleaQGRzOgFr6GEYF98crXeilBKo9wbz00iFtMRVc1se+0vpnTmTOuONmRQalVCsd8FzDmSziQzfV",
-	"B4uO8hGBq9/gH9qvwXLNWaAFKCgkrbLZjPVlPy1JWaotG04cFUY2lyorvKRQeIbM99o70zMUVKjYRw3Z",
-	"MhwceBe5VccAtagPm0K6Iw6t1Uwywvj1G04iWCl1+OLbBRUFu4htqTJt6LNtOpe1ZnIMvEkK2BOTyTW+",
-	"O5lwtl4Z/Rxdk7AXMmcjlJEDMlTRWuAMnK1fBzBR6CsxAyVtqe08BP2d6YiTHKt9YEwgl2TR66PJyXuM",
-	"KHn9PLakQ9pDqnJc7A69ss74KMJFwP9Qn3v+kIofFLoftXlBf2aQrfg76BVzP6T1LGy7qP06aSYZ2N+U",
-	"9aWMbhKrG1k4g6UYtqlYX7Q+sRpLzX9lgRy4mdyBMGVZOcREaXd6G4fsFZSlhbpF4XmofjjvYWYmuWrR",
-	"IdLduD+0PMEJZQv0RnosxQq9Wb+xYZj2IvMUpIwMDAECFtrkfBZfL2qQfdB0WLXjppwcVZT2WpKXlEua",
-	"lTkatnGjo5h9DfhDoMAzSVlK7XhkdiGNQXINct4p1UuWtgcVZKHmCHlWGdUDOb70DcJbQjYIclBJpkIx",
-	"DOkHqOs68hnu2sR9cB8EWDMkYNyCj/VlSqWG/ykG4MadwROBcGYDIewA8yFxBZXppmwCcnPEGRqghcxQ",
-	"WW7VxJL80eGzjT6ArlCFM9mpNstZfMjOaOxVmtj6DCRhwJAi8LwqtOHyMOSuw4QDW4YOhWJw3Tamh80M",
-	"ghqX7t2Tzmv4J5zs2NBAKMDFK03PrVbckIEDCQVmrrNHKuOntFGopOVtarCze9OdWR7GzuKRRRyTzJN7",
-	"053pTpLyTTluqsxsexo0erD+dTzyDYxkMLAAaYMYzDXOQWwt26PgzfA+DYIY5pzDPJk3B1GDe3e729vv",
-	"cDenvW1z0zFIvcTYHZcx8XEAvrhfsGSLz7d3rluv2cBseCvoD2E3Nz80dtXwqgfT+ihl5BzOwZK7nc0N",
-	"G24bzuoDmVlWIJitkOhvhfyfG77ajt2jIKZq6wSqIuOJBran/vVKMVSsX3dvHBgMVRrF6V67vTMZVERb",
-	"VNKUoLyjVChmyP0CbgMy+7SXr3grR2En4+AZHMoMN1NQvclEb32G1urf2rysdrOh9Y51IS+lkpRqxiZ5",
-	"38iXL/Di6lYn7oM7R0eJUQ51xlf3FrhBuH5zKjNNcc5h/wCL+J47M6GFNWhgkRWpjH3p0YSUIp7bUZBs",
-	"IkvbRYdTdAwWPK/QyJjXUMVrfTiLzsBqTps5jxOaUr0ROnmILp4dpr2Lxt+Nm6cdMnuBF8nVya9IQ/Vp",
-	"7CYLHbANcGgBi0XdGrszND/f/vz2h5rbpPTA7u67YLl3g/fDucC4Jt6B3xj6M3DhbCMY71ovUBAaS8VN",
-	"52I3YGuvXeafFGWd08ARvO2NbPdjRFtHD3eCW10uZ/IWwBHtEln6xVZIjCjqbFRMfe+PNJ3fBMSDrgB3",
-	"gmL67pf9wI6I3ODn1mt/sHHtr32Hgy8x1C9wUMy4aM/awu2nFvw5noIvXP0qx/C49Fd1ruaO5iiJ17YY",
-	"mvLjIe9rNfC+HuXu4E+j/au7eJP79X0ppNA9cd/dk/Tv3JPG7oZ+LD40uvd39Z7ehbQC3chVlkdgMug6",
-	"BjcL+9cB6+ZfneZ36rrQiAznmL1awa1fiVMdWh31nYRQzJFrNueLm973CMyL4H7fqPYS1IdLqsZfCypJ",
-	"Dfno7j9IRfj/DHWMCnMTJm4sQtNr+gjjYOvOeR0WvvptkNCK9hEb/t1sPkI/CuVytdDmQ+TC+I+H88eN",
-	"OP90RdpN0e9b+RP3lz8WCDb7fdcoV4FbzS7DK1ZX7w+1jQZme3zWoMqOwuppeLP8Q2SGt7zgPvrG2q3v",
-	"use3zt7ndfffCuT74RClvmSiO7eFPhbc36SCcVfoXM9l5F1/Mfe7E7JseLEhoHRYmISurSh0xvexw7nZ",
-	"ZTDd1Xw2u8x1CVJdzS8rbdwV3zA2EhZFvBrOv/aKiITnWlEisFEa63L9RsmN/wiApu7P8cX2F9sbjz/V",
-	"xoH46tmzp/03rNvH+L7w5tnOS48l9BZNE1S+JMXGR/gYjZV70ij9chystvMyZRG61fFoLfpfB8EbU+gS",
-	"VHw9cxhL2/ps8MPVydX/BQAA//+HesR3PEMAAA==",
+	"H4sIAAAAAAAC/+y93XLctpYv/iqo3v+LbP2pliwne2aUSs1RZMfR3tuWt+VknzqJS0aTUDdskmAAULKS",
+	"ctXcnvt5gJPJxS5PVa5y5ia3/SbzJKewFgCCJNjNliXHjn2TWE0SHwsLCwvr47d+mKSiqETJSq0m+z9M",
+	"KippwTST8FfGKr3Af6hU8kpzUU72Jw+WvxZMClIsf3zBC0EyRhTNtVCEkopKzSXJBJEsraUSJBWlqnNN",
+	"MzElf6tpmQnCyzMhC5qJhKh6pjTXNSfCfLv8j4JpKQjN8+kkmbAXtKhyNtm/nUy46fq7msnLSTIpacEm",
+	"+3aAyUSlC1ZQM9KCl7yoi8n+rWSiLyvzEi81mzM5efkymbBszg5zqlR/VoeiVDxjkhJasZIqIllOUy5M",
+	"V6WZXMaUpoqk5nOmpuQRTlARmqe0XP6DZkIRJczbjFRCElFr2XxA1PInQUTBNc+Eas3um0lGNZ1RxU5T",
+	"UZaTJ+ZZlYuMTfa1rFl88mYup9B4iwJcswKmZ6evtOTlfPLS04NKSS/N30pfmt4nZjEmljh/YZd90hxl",
+	"rNT8jKc0E5Isfy15KnCJQwolpBQEFlYLIiSfs2L723p39zYzlOOlCKc8uX+yfXLwl+3jvx/9zb515/O9",
+	"BydHXx0cT+x8KwqLa6f7nJm5S/ZdzSXLHGGaeXema+bzIs3rbGi9jwuumePS/uoekFmtUmrYV/OyNpyt",
+	"FHCvWVqWs+4SlkxfCPn8VF1wnS7GriEO8VqXccHni5zPF7o/50dsbjgyYzjd72qWkO9q5nchK2AbZtKw",
+	"akaJqJiky38sfxJT8lXht/TyFX5v+IF8h3uaalZmjFDzd25mSaTpbJ/U5YLRXC8uyUelaVbROlv+eM64",
+	"Sggv07zm5vOYvPhjQliZVYKXWpGP+s8JSwgrSEoLXi6ESoggltP+mOBC7gNnpfBvBv9mCaFaSz6rNbNP",
+	"kUQwS3zlM/z9nOZC4i9E1KQUJZuSh4Y4/8XUPslYxcps+Z9lyqkizP5tNr9y1CmIUKQz6U/JOf8extt6",
+	"y//Ye91Nr3mdKlKJUkOvQjnZ2/mww51+FSbJBEnjRM5ITm246nq4lJerNucjpoUsA1G8+S5luWh92BHH",
+	"AwJ4JDV4ef379vlmohcmFk5icufzvZO/3Pnz7euSn0qL6kAPrNARbN2hlUlIQS1L+qWhdq0aJcFI0fYy",
+	"SFFrJkcughnfKdXXuggXbLYQ4vlRtm4pMkqoUrykupY0TnGebUTwl+ZlVYlSMRj7F0LOeJax0vxhqMhK",
+	"EOm0qnKeUjOqnWdKwGNPQvOmodrHu7eTCZNSyMl+0NLLcACVNPJdc6aa73pa0fKXjM+B5Uxrk75W5bvp",
+	"fnqflYrOWWH2XvvbgMca8nyDI3DNPfFvi9kzlmokUOcI98cTslrFJKhXwGGUCJIuqFEzYZRHpWaypPkJ",
+	"k+dM3nVj3pSwn+zuNoR1bRJslGCrvy8imzkRDhOFESomz7mn6TnNefaIfVczpa/Ep3t7DTk/pxlxbf2+",
+	"iGhmxRUqGYSX58sfc55RM6QH4iHViyuRbvdfGtKVghjhQ86kKAglWpB0kkyUqGUK8s5Mico505P9Sdom",
+	"7v8n2dlkf/KHneYquINP1Y4dXWzvBYcre8GVZoUV+qxc1F4rI5nTjJymlPM5JdReEAj1OhsSQ38h6jK7",
+	"Gjk+bsjxQGiCLf3e+AiVYBB3rDQ0kjQD2n1V0lovhOTfsyvS71ZDv1Zjv0N5ljFCa23mh+fH5KWfJMzr",
+	"QGqe1jmQ7KHgpY5NPTSctPswdg5zDgHzg7qDl4JUlCzVNKMKr11iJmlBFFy7Go2uT6xzJjV7sW6zfo1v",
+	"dSlkP07CERtCcW2Wf/JQlBoJYueMBOlRMpmAJvhQiorOKU60R5MB04r5mZEsUMgbtU+ZMeaCZqczmtMy",
+	"ZeEyNopbJXKeXq4jQTC6h/hBj1+ssmjbaxEiX/6seUphzbAhFNgZI3VBrW47SJmTuiiovLxGqijQKqLk",
+	"sLe5U2nm2m/7C9kM3TUeXA5J5maTmDd2CSW3JskEjTeT/Ukm6lkezLSsixlyotJU11ZBXbUQJ/DeoagN",
+	"txm9W2iar9sozWWiT+xgNzR32bHtde7GK9sf4BecQNK6SLcXISBOwFWPmKoLNBqt4B/JDePlX/t93uag",
+	"GdMXjJUlU2uFjTcXFJQrktZSC0VYqSVegMM7sRFBcF8uYHhMaTaOCXh5mrG5ZGz0AnxXOzXAiHYumaZo",
+	"J81sr/1VFrUe2Utbv8DWVfuS3G9e0vJ5v+GHwilppWURuPDBTwlJRcGW/wDzAivIrWi7ryWqYVBJI7Eb",
+	"QrfokbQYosVs1kYmQZKJdbzGU6b63OZvz1HdAygLzEUFKVgpVNid/3bV7DvsHrmWF0xLnkbUg+Ur84CS",
+	"WtGMRhZpkkxYaez/37SI16XXSpXCdu4m06dvM2MVpfBlmrM+WY15PULWO207Iu7U0HIlSMrTfDRx72Zz",
+	"FiPpebDewwvrG2TGlK8Z7lKjt7wYPYKhZY3oJTCgxJImIPShnXGctiqmjdWlXisbTasqum1T327XK+To",
+	"ofoEUYZcuBdARQgEzrh9YDpdSyecmh9il0qqKwLjZNNCpbRid3OYQX+iXxWkXP5CRE2oZErT0JmTMcLw",
+	"O2QS39r0GahSrZXIqIaLAs0ybtqm+cPgORrBOjsAPGeZ8J1MyYPlL4ro5X8WhGcJyemM5dakTxixx+6n",
+	"dqTBi3jfTQhede2HhOGX0z5hOpSGoQf0tcQKJjyGthEuuhtSby7pmeiRzUuHkZzTWc/Ihi9Fdq0tdoiF",
+	"zUf2bjPZcWS7J2kV8TLfM2QKufDPJ8cP1nIfC9Zgk+mq3vx8S8HUcEwrpwXiN7a72s5SK+gDxX+TO4Oo",
+	"ySHVbC4kp31HbOcWccYlu6B5jr7lpONrTiZzqtkFvfSu595d4/kN+oTBr9g42b79Fs70BT1nbZFKaEUl",
+	"SxlhhvIUTyq4lc0MexqbmiFeRqed2Xuvsu038DtPkpVe6BgpQKBEIiJEwfqTxzs+k8sfjYVsxrPuyhya",
+	"UwR9JAX5wi7TJGn//jktUxDBICajo3Jmvu6w/grir+c0Mo3hWgzRqk2ZaJ/OmrhBn70wgA077WxQdGjh",
+	"iiT+nmZp4QfY0t46uyS6eb+qMqojxMRTiuaaSXeLtAzaafXGNnIvSORNsudaNhy4N+OQglU40DXNnd0N",
+	"L4lrl+V8QGWhpKgziL3BIKTlr+Dn9afslCz/N2HlObcCQxBhLsiVIIaWdgHZOVDj5OQuSetngvCMMPyR",
+	"SFYxzcBBD58pfGgGON1wpYOtYAZIuypbbKFXWX4iSoaCoIiGEEGXtDIKlbBzbWg8EyJnFPyCfK3LM5VM",
+	"pXhrdy0lcBGD6/BfqdLbsFLbR3faE/p470lgV+Cl/tPHUSU8etAc4mGwAfnufH5rIzF5jK6ITqMQW4Kz",
+	"VMQoOdOtyQZy8A4Ku6u2yovIQO8L3LhZsJCNuYZqtg3fJUNu7257j3kVNLZP0O4wtRyWuL/rsvtLKhnV",
+	"LGveqLLW38azD3/DDP3b8Jd/F/6yb5pFnRs9cMqLSkjNss6adoeyXgCB9x1eseQMVdTzIVEDyqjzyPV0",
+	"OOfUEjJjJWy2mCOrKxgGd+zXTJqrKZeCKEa0MAdM9+pve2RKt22Y0T28ENW6+z22lwyNfNRdwdDnS1HF",
+	"Lh05K+erYkb75jo/pvaK7z1Za5q1fTUi0RIgvCH7pnsr/SV8dLigZVxhp0RLWjpzYCBVOzf9kWttpTMK",
+	"4lbb0ZWsJDvnolanaxvEgLMNWkQr9bo1RvKgGX8ChKfWc9iVSZqfByG9Memj2DmTXK/13py491YI67+Z",
+	"IEXsTdSkUQD2iTBhjGZ1SdZEfoBBoxTlZSHqrnvlezqb8Rfxo+JKNFojtbtrNEZ0d1jevtQwfI9PAg3Y",
+	"TqK/9MEGud/Tn4b3yh1+djZwtbVm5/oZddvErAspKVG8CHyK7c1CzzSL+IBPsIXex32enrEzIdlgC9So",
+	"mmC2J1SRM5ovqCLPlj86715GFaFCWYmERj7QEkd0fT0uWTuBxJIiWJg7/IxJNnZpvuRKCxmREo8dx/0X",
+	"U01DbSEGhwFa9EvN5zZ6yv4kGah8Y4+GllR92UzHDHD5i+SpiE3HHx/4uVHIeETWZFya7W0jjuFQpBmG",
+	"IwtSyeUvleReT/yU8HLBJNcsa76wz4ha/mJjKJRe/uiP1ZxUQpprkTEsmHHWVHXOq+arwNWAAwOHje0y",
+	"XMpGv+zOuxE4OPFHrBIyEmuwRvhmjRhMyLymRqsA09kiQvUpOYnnXGAnXfuJkTii1mZLmLFR8snHt/fi",
+	"MvMqUt7LWZrnx2eT/W82kbhPkoErkF9oG7D9k9gnmbgop852jMu4fEVoyrgWn5JaMeB4mfYc5w13TGEj",
+	"h+YELcbszhM/y3U3tnBbNoM1D+aSZiyDaFpaoJQKo8TN7Agjdfm8FBdlgi/EQsItxzbHhWva/FNclODi",
+	"hkZaCnNnpDEWPioqmuoV6ic9YxqTZFg03h8ltJySMLYsCzJorNeNKmcNLMxtykemq/6dfHZ5mg5FmHdH",
+	"1bjonUAU0otCVgo5VgYiIeDef0+KOqonzy5PM640LVM2dmzm/eV/4CUys0MKRPWm47tjux8cYmq9tKOd",
+	"/H600TVry5XbT2IGAFXP5s4bsFKEuPc2jixxY0wg6gp2kyQhE0YsF9cRgeJpY04Xo4LYC13Zu9UFve+u",
+	"vwN5ijURKn7d2sEqfiu0mS/Y5MgX0ctSj6WvL8hpViteMqVOIbA4jRu9Rrl3gw6itiU1YFxSreGh8bCu",
+	"WrfhNXkEA7ZP57mFvvuEXhMUFNumV/N7ryTMsBi6DxKmbG7uLstTL19FBXh7ZW89+a3XwU9t3FI0AnZ4",
+	"OVg2FKPlLxHqilFRLGeJjV4yt1ZeBnFSNxgVGow7FkkkivB6NEAYIfXdeDzvVwUxiwdTxRyN5f/NwO1A",
+	"0NKHbNMm5VBssNd2JXvG/DU6FCbGpkgkg6tTypTTh6xdknzbeJu+nVzV15kJmFLibhWpKBcs7btPVnsS",
+	"n/MyG7bImg6cPdYwg5lX1CJqHfDRLqS4WBXhZruBy25NSW42S+Ma7EW7TcndghyefA0ulJl5ki9Ek+JF",
+	"PyWCVJKXKa9objVZPJ3O0HePqaaGisYjTElm+0xADyc5LxeU7K5XE7puQENInGxifYJNMLhTX23Mt2W5",
+	"wQhnZOXmFtZVy5yiagOCw+am5EizUvnkDmETvoFV8eg3O/qcZzXNYU+DBmJmJaUoKiZBpBqBhQ6WXOiI",
+	"k8kstjq1FvXYENs566nkput4BD40JVkhzsc0BS/CvEpBCgF3rCqnKVvRuLX1r28cM0jAAEOtf3Bw2GZx",
+	"YwmJQP6G3Btow158xSIRoxkO9838a81xoKRs80Jnp9aVYlJPkokjWGyzumi4VWvrgw+HF9U3s2JdbTOb",
+	"LahveMWattKBRixmN+4SUzl6lIh0Hplo0tkbXR7sMrznpE6EABhMRkmLB+wiHu2DoQJwNTNrFSQRdJzd",
+	"U3JMeDuQ5hWxhnSEkEDTkY+dmL6/8QXDLoHe8dyLc0lCVYtkYiAU5aoxL6sGsC7oZa0fsxvQkgyHVjwQ",
+	"52JEOMUDdvGIZXWZ0TK9HLhXFMwE+6/W1M07UqgWNASkdhsVwMy9bDBi6gKV+elKbb7g5RE+vNWXxE0G",
+	"0DhbYSQXqGcwDHJ+7G0jsBjSPO/LKEuXLtXhW1TuDWWHrxEP2EVzg9hAbMTdjWtjTqhRmTdOKxr0qPCS",
+	"p9yod4GF9dgjhixfhbbSmNckql7zsRgHI9TqVRJqnTyySdy7t64We9bfiJ3skxYT/B2BBtZyAXgwPUqS",
+	"BL2zAR4w7GEhC8byxt1SSzb30BouFqWN37DBnTu8QZ6Ks/EddlOR3E148BKchKLVO5zBXNw02vMDWRPb",
+	"8icbczuvW+AfePLNtispsu3deOAQtK7itzVDOdt90H4jRLqBK4R1wmzaQ4lFujzZZDGiO2zFmrOWYW58",
+	"P4qlMnYWnrC5ZJmwcWFongZ2lcSlt8wpJk58ef/gcPvky4O9T/5kIv5RgEhEfkEEF0oOHh5NY86GWuax",
+	"aWZMsuU/UPWQLGUzyKVx862EJA+PTx53Vn+hdaX2d3bSBdWiUlP7bJqKYsfsLLUjKlXwMlsvF8ywPG3a",
+	"AmF42/bFg8cE+M2zrYe1rkeRYOLRGtOjmIK0QaL3yjjfkwYUKkbdY1ktaHmFvDdzX0d0g0hMn5BoxMPg",
+	"9ddNldrUraFY0RrSiNuWcxcMJ7ktf5Fny5/iOW6OQcepYoG/pkv0eMjaCVqagwC4Jp31hmLXOuTxkWTt",
+	"mfc0yJN6to02LiPSfAyfT8PFPI9M8OC0Y4QSxb6rca1cNCENTOzRiU/6cW12JcyEVgXmBM2ZvkNOIYrN",
+	"a551YJn6WV2DXoI73mpOaFqboJmMhivUchbAVsE5rnd1WdPmaC7DzMcnyYoEy2a2OCyjLMD9xGuzB7UC",
+	"41wpyPLXXHPws+daTDHxOWMv1thUvdoRBFu2Tam7pKTRBIw4EV7LzI8jTgJzf8TxB7ttaJfHrhcrmNvc",
+	"Bp0x+Zx+zynocCltPACL5Y+eG82PgBxIIRArlVwvX0kuIq78VYY4ZY1wADDWOd1pnm+rhZCaKR1Hllg7",
+	"SQezohKShRvSwUzBL7ko56OlUBNmHFOt3pITt295sBY6pFg/zDZ+UjTX8Ptx/UUUIojPy6hyiCnK43AU",
+	"U0LLy/0GXBLeAJELn7n3pubSvg/hXfYX56XRInN9FFPy/FScnZb73ZcwnT1j5LkbQi/uekr4vBSS7ZOy",
+	"LtOm4yC2hpaXk2SCqVzY0SSZ4Fet0EuBnoQQaiS2on07Rk9zeb5SUSiWP5ccA9ocYf10vO0XB+ohes0m",
+	"RWrYT8xOfZ5YMlaSpVzRwpCHyjjqpAviXoXI25jXRxpygIOiduMxQC4x9oxYwgbt5fgmsAUIPsXNRU+C",
+	"bCsa8yLLmUpIYyDztqVW9JbRIpGL1fKXJAhBTAVcbZk5kfvmqb5cHG+paxTV7qXzgs0MnMUFm+3h/27j",
+	"/z7e8PaJyIdD9mHnzg+6Np1sV0LkNwr6A8NKPKli6D/3xhrwHjEl8nOWdYCQ2jM+CJYObRspB7d3R9C1",
+	"Y0vh5htgQbWjg/IpOVD97ImCqWLA/jjMb302mse5P0oWgrC/eTDSM/o9tj4Z61Tv5nVNrnP9hw/RO4wI",
+	"s0vPjW7arNJ+zHQENw1VO7AgIuoAHhmlvJP5DciWNc5n7IzWuR5rSrQz9eMej0u1ys74SAh9SGsVO3Vp",
+	"mfGMImp7SmtFiaT8ezNjPFE7sajHjTPfcNXyVdG65qA+bX5wzaLPZWygpvsuisERtOn0r0qKcxuxbXUw",
+	"PKzcz2MVMU8h10kUO2XzuMThML1gpi0Nyq3AynVsRhnNWGpd7NrCA5IzlGPxnuiJ5Zf14pv0YnyQGJ6Q",
+	"0TBfBiEkdk3HhIUaLGDKy6HAqtCQ3EP6EgESpYu0Crpfh0G+Pilt/QUiWgzB3UCDkYCYhpuT8oYkNSV/",
+	"5QXHL8itXb/jOprWN99MDh4+NGe4yX5NJicHD25NnrQO7/GnePfvdVhZWXcaHZSstUGB1wqa5YpCeK6J",
+	"XljscFvCL7b1ToKshq6l2zxBU5w5O8QsZwVtZcK50yEIyS3oM7BfFhwjyi+oLM0ytOyXruHYmegGZLH1",
+	"+kbiTcO2zSVKNXMJxhpR12H0V2zZTT3SLC9fo1leDjTraHvFht3n6zECN1pef6GohAy6izIfJKGZQQcQ",
+	"ywPXE1QzjVqDaRTgN8TJVUxS2UlqI1Y4C6mZx0tovC/9XL085nBpgkNTzlpVEHx1B2JLqdgcNOfRiycw",
+	"n1Ger5gj5OdkQkXTW/pZe6/pNXvkYhqaqEZPsik5nkk+d9FC1n6gUlExn1uECe2xPWwaOWdysMvuTK9n",
+	"kjC6aJ/ABH7t93Hk5COr6v4xaa1y0lrkkvH5YiakMvxm8e9brtV2ePHG8AkisBUGNF6+cp31tWsvR2Mp",
+	"nPHNZQ7lwaCHlOYpmrWVmEmLBbv8peI0ABo5iKnNeKPrMar3EvQCwI2ebbMaJaO5ZyWAnTHO7n6GE0tp",
+	"wU43zUcxPZ+L3NwOac+KExWmtqO6vEpXGeMvKLoZx/SVQv7oSigDxEsgTnB1U48TTK7YxBcXJDnf9BXA",
+	"Ti9C1KS7oB0oWRt/PJCfHDJ3Oc8ZQFer47MvKM9rGcGkpAHO9SlW2llBdCCyZIVw108HaE0aVLtRpO7D",
+	"a8dS5CQfg5h5bYOKA2d2VjFGsGaoXVhtZaOZgF9BPkTXSlOp6+pYZrEj4bhEQ5GLvfLQSTarIqWQEIHy",
+	"5hztkIK7HUIVoSqSx2wETcaUAePHYE3jGAPrlID+vEcbgNUUjdzYhxAzo6CUnaROEJIAxwkJAQV5VgO5",
+	"Sgr2LAqjeE0YzWRiZiBjocpf0Fz5A4Xmcz8YXlTMBvfj9JnSkmsaVVaukq14Ye504xc5iVgJO4gFY6lk",
+	"uezv9DxCq2FZ5Wjohh7DIT12sQ+d0a9gdhhGTxyNwKg1LF8J0x/kEEGH5jRlBViCcpYLUjKlN2Oh4TiM",
+	"CzvQwbsvdtTz8a6/NVzg2eTnHBK0xGiAcfRsgNYjSWk2uXs0UrpHVfCfRpMGTX74FdoUF2W0vY0B3X2T",
+	"zcEZS9PFRPbNW3Vfrl3EDfPoW3c/7Cu6qIFkGdgHjChW94tU0koyxUqbh80wmYAsf9XcWkrBAlUIE4bI",
+	"VYDENx134zuB8EWLWpW4O0Oj0XLIwnFqcWK9E973z5Rmz8AjgYUwhArR4KdBhMtBnhPcujHRO4Cl/VeX",
+	"w9YlS3M5Vky68F9Y8KupCFFESRUBNGls3qtQgdvD87UVNx9gIMccjowt9BgbnL/YbtzsuPhqh06gmFLW",
+	"TuhmE14RA6U+LKHYydZMOnpfJsh9nkoBaeMm5vPbMH9jIL1z+IAJWKdnUHktnukvyaNeOnb8FMb3w2Vy",
+	"UQUBVyUrcdRP2NyCTzFyz446Im184ZJeBJmqi+WPkgt79W0kORg6JWVKy9qWwIuEn7PxydBt+I0AQMJB",
+	"rA/a+Jf/x77FyvFpf62SLbGgdoD0OX39WiNmXi5GBX0vq07AKxZ02bSMi8cpuoYJ2qByWD8wpUeBk6KT",
+	"tpBBfEQ9mbbV23yrac5OhyDE2LwuMxsYa770BSLCGtVmjxvOqlulbVNXCBrzxJoUxvgUYBgD0brH3jEG",
+	"L7TI2Gq6XQtX2dgiN/ChZE/se4TSDHdkDGekHXIsX7Wgv0CGN5RNMHzEvyDw+hEig6mE5OiholB0NYSU",
+	"ggc2RqYVqywRO+mc5owMjczb1l5fib961aLqlFvch1XkbapAbHwt68BKDEWCnw5oPFEOa+s+ViNkcLsG",
+	"WOGg5Oqtvd3bu8158XigkSj74cCG2W8V968Z1O3IkEC1XF0Q6rRkL/SgJ9YjDszhsjjnEHRtFg+248pD",
+	"pgkMdlYDbMoCyJmFzZnuoc/vOOr8qx3hZ2c0x1LUe3+C3fHZ3i7+Bf3Kz9jln78/eiY43fv6k6NyN6rF",
+	"NPO9gtyJ1MKyodoVy3hG11B4Ew2qV++7lyexTna8tpLVHkO+VuUK9lqPwaNk6K9Gvy5Yk1QXHlrts6Mn",
+	"zTvSJxBirXMzoqnEj/d+TbJMoE6IloaOMtfTEoeyVr8qMMnUtPL46FozVBNXDzqZPK9nTJZMmwT/vFZ6",
+	"Zf7qqfAAkusN/hZsckTya5j0GqY8i6y53VLZBpNsVKK6iCtGHZEB8iHRsmZPoijtfmVNpdfBqLpOKJAN",
+	"mYJcBzzjjUYqatLKrozgai7gri+m5IQRplQvdcS7lxNC25HYWVCUnUK5+tJdCFlOuxhSNmhCGvo4nMp+",
+	"3i9WNx8N23PVvOL1xS+uJ8s48f/eC/59e6BHpU/TBUufr4SFdykrsoNNGUw7gbQMYRYPtcyFqM+74EJ7",
+	"u3ufbO/+0/berce3/mn/9t7+3j//r0mCP9/ag5/33M9P2kjGdBCEfh2KaWz4LqzmN0IlHY9FOqo4SSOh",
+	"g/X0/XXFV99uOCyXD7SWfFbHAwMJxYeCaF55/KIg54ngF7Y8td82RDRfmh9lC1o1EiEYdBtx6Psesqbd",
+	"zroeYa6QIEgSxI8B088ZLyEiXnIh/Z2UvWBpDW1G13511QM3hH2CXyTEKjwJsQKXiJrkvHzeGaTtIPFy",
+	"OZnAW1GDE81rNk57MUtYmyVUX8NHPd3EtN1ObnPtt8qreMKuYBXsZ4UO54jTqaO5gTLWcGSglfXbj5gl",
+	"4+ToV0+gOaKtuLYS7xwCi51bVacKi9qtq5mJKJlNKeyu2Q99/feH3kkcUNyOg4U8/WJ7LrbNN9uYEdGb",
+	"06ldYzfbh3SoCkG1/NHcVBzmmL/AZCuqh62t4Ombff27tblynUK7ETvMIfwO/Tntw3YcZFo2BgD3rLPh",
+	"WncifsEzfvSno+ePDu/zI3VUKH7M/3z3b8+O+Fn8tB64JD3uXY18NiJkFityxnMtu5mqt/Zuf7welc67",
+	"LqDvMC6gWc4+LGd3q25ei+rNwMS8IZSVQXyVSA2pwfNxEGjlqzaSSgAZEcGL+I2gVSyY2SnVK+tKeLig",
+	"0QWBPmC2vEWYLetLb2Uhq3YIcWv8jegGcGDeUiAWnk0Si8ZiOcGBg3pw5mZrhZJlLEaLFSt3WM7NxWGD",
+	"SqvtLy9D9eiug8eJ9e1J3m0gKtk0K7WR6jAPZHIqg3J3tIMkBcmFzPUPWw8fZlSRsoX5+j+3j3EZtk/4",
+	"HL7GTGy1oHuf/OkzqKOZLgqaYkFpWUFEzIK9oBlLeUFzeCMCbkq1ZkUV27VB+Sg/MUXOGNcDnqUxgtMG",
+	"0Ln6Z+RMcMLKM54zLtHdM06WDoDrIOhso9z4gU+GxNVpXBA0Jdq8tJqMqqeHjY6p/RY23BvbKPFkWSfB",
+	"mok8E4NM4/k2aiJQlSgBgnIV1NGXjx8/XEPatpssWn7DHF1wQ8hwRFiGzqQg9MrP+Tfi9vgqW8tsA6O9",
+	"Qg0oEG2eX1qrHJgS/F5q7YXWWJ/0BM84mfeQXuaCZrFosyGJNMQug1thbSTOua1RbaDxhq0Oq0/R1XRu",
+	"tZw0k3MjDNPJQMjZGxrbhJYbHxuTl7HDauWJgWhtteT68sQ05hIHqGTyS62rg1ovDJlSn8yOz75wvPnn",
+	"vz+edFF1PodXiBbPWUlqxcs5oQRfhBEz/04zHnO+T16+hIxOzWRJ8zsijWzMe1wv6pk9wPe9WjCHn0Eb",
+	"sHoAy3ZUxVI0jJ8JxEgrtS1DwwrIJppoKlOuuJgqqhSV9H9kouAlF6al6cyMz17SH9sXyRfkBF9FVblb",
+	"s8eg0sFpAUjMtl7WmZAlS5n0OZ6KyOWPFYdoalCPwZ9q/sRYGrhzKXSbuDIpDvnR7CraVO0yim5tYcbv",
+	"5i6hxJdxUiKv7cvM0ANDRjNqs4cO79/5XCVEcaUNJxGABHmlJU+pcd6LOeTuaElTkIYFRbubgw2wzk0U",
+	"Y2JmroXoGs6JkBxjDQkNc7w6NR6n35bfln/4AzkUJVRbUmS2/FGZyX5benIqBm5gTs2xDNvIOaPMeDPM",
+	"hYKiftTFFoK1h8mCa0YKkbGcytYoMAN59oxpjA+GqZQQaW6H9Afjx4HWMPCjqITCYhD75oVtsrX19fIV",
+	"OuS2tshHoibl8hf1x33yiLlAy8J0BBZJZVN4DYV4JqT5oQhD1IDOM1rahACY1BS7OZBwSdzaajfdn44i",
+	"525EtrsOIgRANQBWAU1FlbsogLO6hEXjuBwPmNLMsURCutFcEBYCJM0aPkBCNZwsCCM0Xf6c5jwV5KM7",
+	"B/f+6Ml2p3nLzOmglQOol/8Jq6GAcpCDnfEUHUTChNqxosrx7vDt5MQSjxzYmYLl1//6+bcTR0I3FNPh",
+	"sR2tr0+wfGVj/NWnmBn5jCZmDKWrBAd2Q9uDHLrOmq4Vx6jWKXLQQyFhpNCfagii/vXb0uZCsMI97Xla",
+	"yX//278jIEUQhOTnuX4Q5L//7d9tPHzOzqkklGxtKcTQ8s4ThfLErj9hJF/+MjdD3NpCHtq3ixY691Iu",
+	"TaKL3N/aIncEB95th0hg5kZR69qPy4e/JJj6ZpaUm12llj8bj2hm7PgWxRYjAdKcShrPfAjTUww7h0af",
+	"qWMzOjc70ohO2L9SFKB9mlFb6mfm/K8h1Q0oec7pDID7C0IJLU21BsUs3jtNtUGAbpgwwWJlMSYU5PMe",
+	"rvhh75eDxOzc5U8WMQXAgVzQCK0VUKgurPP0X3FWD0OQD14akqHVHB2zykzubtEIdOAf7zP5CPbrljdG",
+	"bP0xANJykG3hSvnsF9gCc0gW3tqq2oPAlcvo1hasRTFb/jyvIQESBzX1LJSLS8NjFa9YzkuEjprJYNy0",
+	"mHEf5Ht4tHN4J+kIMbuHIKoQmMRQSpJciEo5gkDMnEnXsdgN9NwUQAQtbFbzPFO42prN/UlKay0KqvFs",
+	"nH5bwjpDkGkBLkhlBCFAs5FjPOcMo+hLOKC2tlBM4IGztUVClG5RE+nFtrdKorzxEcNTL5VsslX7jKsL",
+	"Q7+De+QjEJ6aZeTA5NrwFMf0x60t5K45lVAkJJCoM3AI4AoZ4p/R1Iy84W8FsFK8sRaHBCcN78NBfcdl",
+	"+16Sk1RURrdj5vTW7IXePrigkhEMWyMHJc0vFTcHeWddO2IOdYgXWOwlFUWNd+8C7kRVDmGB7pA071Kp",
+	"eb7oopo21eTs2XfGJbugea6S7rlKjD6R0zJlFI5iWA8qDUTcXRdXjCo2aw5HC5VUCWsWgwWi5by2xyiB",
+	"VIdK5GLOAeSSyu9qrpmZYkIgusNGtpm6rSb0FIKnA5wc2GKWnc2K1BgYX+OKRuWRYa52rkZ3/TwvO5lF",
+	"BaElzbnppR9XXxdttSSBUW0rxAAumZXmGJBqjkKTAQjKi5athWoif81aYg6ILYpSEIdMNXOFHVDdIUdK",
+	"CRAyRNbLn0GJAKK448tsWVlJphvEgFRI87fyQeiOMi6r0hDggGxtDezdrS3Q1ispnjHtFfacZ7AIhZUB",
+	"KSvtalEt6fnyFTKT4IoULKUlV4VQ+3jo35pGNsm35SEFnoD0M4+pgEtudo8kBfTv6gCi2mNIpXkl+rvS",
+	"aXzmhLMM/9QV6XiakKcl0xdCPjf/TGm6gN9o6zL3NHGtwNpZ6jul2YxBKJsSxO02sbcbnmvJoG5yZweK",
+	"AhR1UtpRw79SFA5g5ghY2GhZGbWK0t50nQw5yI2WhvcWUxoEbj+IFpEQHJcbOrXiLr4fGjlhQTYco/vR",
+	"g0GylsufFb4mapLW5pCSHCUEJXQmZIaxorZTyWAPykCqBtvUBlkBMqJFv/Kh5AUJaGS1r478IMYlwY2W",
+	"4jaeWXbCpWRma5jNOzX3JWWmRyua4p3DgcYheWjGvqs9hzcCWTIMTw5ukgkpfQA8JaLZM6ZZNw9DOkKB",
+	"MmaAdgo2ah2MfVn9vT2A/XamtREgNkoWjh0sMW5khXUBuQPZZ6thHFzOU1YiWpm9kt8/etyzA4iKlQiT",
+	"NhVyvmM/UjvmXbAI2XSWCTCMIYSBAhKSSq+7dhUO1khovCg6OBQ3QJxM51CDq3uYtDYgfzBJR6EBIaea",
+	"KTABPVOiBIMMvcNpzlIdzNFc46dmorTiCqYpqNq5Pb21k+G7OzZo2r4z2Z/cnt6a3poEcFQ71O6snRAT",
+	"aAAdFtiIhP69WFQ7bke8yNrnQhrJqSXNfVgOiPCCZY1TEu4fpq+jzJCJ6UM7oq+bcFezUgXTTCoIy+iY",
+	"Ua3BArydOVWqSRTYJ7w8NdmXjDWQJXDTZvqCsbJk4O/hphmDHXvZGHwKZhp1Jitq6OIgm3ybAMnQNBTz",
+	"PK3EXv3xhcVebRCSvaPx1u7AwFzwczOugr6wMKq7u6tBVV8+aezowAd7u7vOMmYtq7SqcntC7DyzMXlN",
+	"VyvTprrrBua8wUJl1qhluP3j3VtDjfvR7nxVmvNLSP49y/Cj2+s/+kLIGc8yBgGyH+/trf/iqDw37OpA",
+	"lV4mk092d8d8hlZLDGGwJeNaEicy9WSi6RyRqO2pMXliPgp2p0dSWLc30yi2QpBuCzpCS7MVUjPU5+BM",
+	"be/rDsKjt4QVwL+iMWbsA5ZEu1cII2bpgkpOmzsklPBIawWaENjCnJVU1K6iWkb9pZgSIK/SlCzEM8D1",
+	"UayoJEPwcBOb6GyhPwlbWc4lMmPKhQy1Zi+bc8J8/LEkWJIRApYhilma96zuYNBi5DQqo3BdbnI3YQ+R",
+	"PYS2ize4b65pA8TRP0ZsAtFU5Rh9Qvm08gBDyVboQIWXsCDLr/XAfhFbdlcf5AbX3XWxSnj66hvvHA9E",
+	"C4isWX5VibMxa99czR0Sj5UMhOJDFqIPAOi61d0yLhm+m0VyU3tcEAdQukGeiHcY4ZAhZKF3jk9WQCTF",
+	"ucXnQK7kEo9F0U2EDBNK2+ttwpLv2qSv11rg1wGa6i30EJrFuygRetmkdoWdLJ88AbjvGMLlEcJI9oqn",
+	"xkvr+NqzU/IoAgICqUgWK6j5ipfNd3hzf4bxq8rcx0vhPFUWDQtMcqD+CFlYxyIoPz07RKcEdEIAmks0",
+	"mlZdkDsH9/oceQgxG3ex7LdEPfVzkV1em7hxxWtftsMetKzZy94muHVt3TZ9rioIbUscvzfXhkPJre+6",
+	"XbnVicHM3PeUtmpwKA13fnjOLl/a4Bumo/Cept5xpPZwj+fuQAuW5zq38dgMm1dgLH9hWGE1JkBXLrYr",
+	"RP0ml3v34/VfPBD6C1GX2dtwrTRruBGHJKsPyRHscI/pG+GFNylHFMtdGMIH7hrirjtM03zBVLRE+eBZ",
+	"XUe46wBgaokvmMGIICUUfxklgDDd5RqY7vqPSzMoHN64E/ONc3oAU/OB0QcY3aUNbX7U+mSSqFB9LGmp",
+	"wEME9jccwPYJKzWBOFVFqCLavMSbmD6bmA0XV4R3ds+acAGfgoaKZCqKGS8hkiKI5F++IswEtWQNoink",
+	"sJHgKuSa8Rk4yjjjCvJXqvQ2jHH76A6hOMiCK6u3alFQQivjh2qSs223kNUB0Vs+NbhJ8KC8BFRaDZBp",
+	"AO3houIg0WCAGBAXURCP5MzOGKYwBFafxAPcwo0/d46+TnGcfZc+ZChDlSNNG+rJoqwH0AfGngB6fBuL",
+	"SOCrEdz5nHhIGJYzBEww1wNAvCaZ/UWSVlkd2sHGPVBghjReMpfVgETD4bRnAtAJBeMC/SznNBeeJBYO",
+	"uDX4+MnukmJWel3umj7XZAtNTUhP86w1MwfwZAFmoH6SrT44tRU6IM7fyNC4IwRzdiIX7PVo9/oSfHKG",
+	"4Scvk9FTa2XqjRihyyd6Y2P8LXLwQLyAizZGgnYyYUiKta4yzG4ZEC2uuwWjGQvitFtSa2V/61VQ489G",
+	"+b6ttGS0aJ/MnQWx5CoFAqtqQU5O7n4KZXyKSpCMauqjTesCpX8k0aB3mH+R1y9Ek5WoPpzhg2e4+Y6W",
+	"Cyo9raIaKhzbLyoh9eCxfRcee5x4EKeAagtySvnCzlZku7h+gLECT/85/57cOX6c4F/3/5qQ+0wWlEMe",
+	"0Z9Pjh9ASOilFiqlFZs+A9R1xzjm2FapyBcca923wK+e4ltPiagTKPlRU0Jr5eqNw+tBctXTgzRllX7a",
+	"F/U4x3sWzHulsP/CDgyP458zqImnEywGUuQJKZrJpW5SIWzbJBN6QELgdDYTDYeW3gSXkZoBXU9hkkBk",
+	"pYihFRszlkvZaMiRijEN32xUMqYZqcc9GndAjh5jUwh4qLLK6DH4+sAbDKMDJD1YRgijoVjRgEuj7gjx",
+	"ugPjQZDc3mA8eMfLZJj3IWTNk8TvQ7dAYXWv6NmEO/E1D6Xwtmi33///osjbR1OPxK/liLb7+Z6vXQIH",
+	"43mZTedW1K3r3X9g5cTq93tHIELRub3+dt9id/9lzAeuvvdve2DaU0561OqBwxJjM0yPA24YeB44TfpI",
+	"oyYJRWg2JQcp45riERjoSmgF8gcrZB5UFLFzTCCkSYc7PPmafPTUoSA+hWgVCAjN65Iq8pxdJgRwQQhD",
+	"Pf1T8hQs4b1XMUQwISidEnybMPwaAsTssT0lD2wR7Kd1pZjUTxthozD7Ic0huFGFeSiZSSVy5bOfSlbl",
+	"NGVPvTZRUQgidZG/Vov3DbtQGKGCmk/NTdjLG/faflim4BzDeMzxX3Sqb7ePf1y0Uce/K0due3SRe80h",
+	"gLQZiqTDyvCh4oz1b4PvXGid/8HSLBZTN9qM90bEY6uoU1HnmldU6h3D19sZ1bTdUjspegC41/B5FGfX",
+	"Z4QbU088S34Yg9W36pE81jb3spec/Gbtm8iij5j575Cd06pMGW1z5/viHjwqBkR43FpZNfWwdwIU/pX+",
+	"oJW2tCC1KETpb6H5wm/RwApAmQqrpb+JGItepyPiLR42BGim+S6G1QyuYzCtQT0gwj07P8A/xriZg5Lq",
+	"PlSbJQaAPjRbucqD3WrqMX90hH1WnmMe1KwFaNQcY7mg2alNDZPuNLM3no5Jry0Er6DSD/CX83jTt1nJ",
+	"vWbXdTVcxZ72eTLmz456HBtg0OH2Q87znTXBo5CN3nweVOlos+MJ0+8OL16/BzSY9kOR83S1CO2uwuRN",
+	"qhR94b9ypPTNi/rf2uGPWfivuyfNcSFZVpcZLdPL7bkUdTUqgnsua4vCh58v/wPtmqFGUYoirk888j3e",
+	"ww7fhD7R6XSMOnEvOsl3T58YmMegAtHjiJ0fjCwbGaQGrNHtzODP14oUrJjJQH2AuzXm8IL1XDNFFDMS",
+	"2eYTxvSJ7kquEeGAaZqJ+LhawvyCzbYrIfK4HIf/XbtKAWvzTgTQXbM6MbQcrxMQN9Rmz3n+jrPQtZyx",
+	"PYE4IAB/Q/n3W/HovTW8uT6OzsQDA0qRh/gazZ4n7xh73khk+wjmvG/PEhZqQZYIb1RZfRc20luhrW4u",
+	"8o02gnXyNRt2bBzS3OSRNVFnHrlDsVa6YRM8Z1FHFMY0BWVwzE8JVLjDkD7ZVNORjObtKugAgFNntGig",
+	"nQitqGQpKwCgwlf57OsyJ25aN7OHbPNclH4936xVOByA8Z7HLcPmyQdf4fVtNaS6rVe6IrBGYRH3bSgO",
+	"v9623FzqOiBv5qcgXc/ZaHz+ptlfJUZvRqq+01bV92gSJ47zWKKL/ua4NewnwqmDBfLfucvg8eBKinUO",
+	"ZtWUGV7JLoiPJBliEimCslF7qJ45k4BeIMg8Xr0uIi1t12s0odW4HYBfA+VX4DLcraPIOhVdA5iPvSvA",
+	"fHyyFuYjeSNFb4Np/Mvu0Dw6ZQ2b2Ww0/oF6wzEcmmBUnwyMSYtqiLKbEhYTcRsP/mAtTQQT0y7geUq+",
+	"Uhb6V2PGqbm6quUvhDoVwpubB4NrbTGwZKQoahc1vNEroK+e/TKKktzbsayD/mS3OH2f4GGw8GakePqA",
+	"0Axd/VGpibwZOz6BG23iRwJHcOnBErD6beAUNJ/KVsZJU/reIforQjG9ICjT1DfPjkV0+sL0A2Vfg2Lv",
+	"TsWmLT9JWIvot43IbwYtejE7A/u3KRmzUUCi7UhINGySDDPp8HKR8TMmmbn/SCMal78qc4Np0WxSSZEN",
+	"HTu2rtIG4ZrBeM679c7aIV6+zN9n8GJ7HcVFyeRnmhdsm45cTerryN3Iih43+2LfRJe1IcQcpQKXnf0l",
+	"egwKuWEU7GNaUFuZ2m4qB9h4izDyye5u2Pcnu/Fu+1qED74yn6w/32yVNlHRFLO+JKB7m63phkWoEW5c",
+	"yIGpowDZNBLZ3g/wYG0d+MEp2uwwzFlrVdImjOAB+Rkes4kb/+/nmA0KBMb8mJF6cu/LcQpnn2zV0bsK",
+	"rIiJp2yyngZBYhCSA1fj5kA5bPtvGJYj7DWeLvCeInL4soIrjYxOS9tJc0blNkqo7abuUJwD/8qLKqh1",
+	"GxRrjlhFLHTv8hVZ/qp57gBs8BouSSfnM2gM03dNAlNBy1qzEl/oAer3+d3MpVXkeIxH8qQ7mZwXFeqi",
+	"qk6ZUu+g0QMWSvbWaTxbbATSYqt0K+Px7kXY48++eu6Qk9tLqc1QFJ5vAOGCYuEDdst47Jb1smSNj7p9",
+	"fc4AtoMiml1ozoEaQMufz3hqygUp7TWDpjZyXfh8/E6NGoS2orlRjyxWIhQqAlOrB49uKi3TM4vByV5U",
+	"TPIGkbRWNcCpk5QqAQmMmAAvUiFjNrp7TN8A197w6diDTkE6f4CduQrsTHd/jMabsSAzrAGe6WwIB+42",
+	"BDnz2ox3/apgqwD0G3a+rdcGP6DNbIY2s6EaCfrCjrd8qBGqgwi0gtBTDZf4wCpjQukK0k42Dxp8muCR",
+	"HmigTbMNjMRqveOgGfiVtlTSj/L24ydZa0gIgNFYKlEbGQndEXZyHcalmz+DDGVrpGxkdx54skimNHVg",
+	"uI79PuzUNSpawOkDR9JGKltJsdBZ7nBcx+0krwi97jZ6e9gxEx9Uo82OjnWM2NaNqE4Xgxau4KYf50DS",
+	"dAcp1FC4qXVoYBq0i0aETOhPsbAUOGbhqa0ZoFbrWNfC0zelbXXZ+U1rXFfYTmjdoW1bzoeNtV4nu5qs",
+	"j95CTnyU7kqdCVUxPAy8n7O/Wx5hUvyH7XKT28UHVn/YL6sDAh2drrZhIpeaFvDSulCwEC3EwXv0yh03",
+	"YwnA7Ya1qjtt5KfruJ50sIxkU4iAqsiQRWPkxRKJPhWVKc2eQRXKkqUaTXsNjl8bFwrcnyMBkLw/GO9C",
+	"sfCDEfi6kFY5GfGyD1we83LGKr0Y8yKgsGTjR8FebPa+0qI60Pb1m42b8mgiEYOi484uc38QUsOGxEGa",
+	"rY2r6kglfQWZFC2wehWJpG9eHgnVG+54aSQ+SKP3TBr5Sk4Bx3yQQ2vrXoXUGiuBXJjgCkvvfYCgDoRL",
+	"DI0aoKfnEqoMG2SxVMgKwgZEBWaXfN948gCZzcomn6KUGMWYmTgCiMFmRNi66J+aT7B2bVUbieJCoe1z",
+	"tClDTwkmRBW+yUb3PsMUu4uyLwrvU/ncOjzKJl7yOkThfRggyagH2E7IvKZGOEKsz4IrAJdK24GcjWDT",
+	"vGCi1qSE4EdNyScf394bEHSSUdUxJb+hnEcM0/AgWhaMscoph0bWT2cI8bhPP8NU0SVdi/B417JEi8cK",
+	"24EglRSz3Azi5SYBCBbCPboXfpugk3dMaoFUkatkyjqbSDS4KS6swjaHRMCXv38BcCXmbmj3gZc34OVx",
+	"bBw7kbnSYkS+2nBtj1C3NrLouxor6uPBK+rgiB2+HXxph3E928H6BFoDbpLDmNJGIruiKFEkbymKFoN7",
+	"gMmMarZtdstkRIns6Dg0YKKvHYIWmw/gJjVoFFhunSIH35dexnzYukNb98u4HB6nPPOioqnepDLoletn",
+	"ODR3UJcNJAFGWXhgRQCUkCQz0xkEkMGdfYSjvp6NfWgzqMwepimYDrEYuLRgkEHK5KxWvGRKnULQX8ra",
+	"OTq9pyOzrmxl+NPrS796crOwszSNwgrgk/cBTMnNdOw28yUVrsFwz17fbvbAD+eqW+iD5eimLEdf8+95",
+	"ufhQyGZ483kKjd19FdWLnR8Qzf7l5juwf/hhomE7XJCBDei72sZxY9ZwRvFMzq03NaWFGTpm5ae1HKge",
+	"+hDhoa5FbTVCAtJbMiFtxfDwVG6KmaysW9IGrPJlS8ZDVm0kM25y7wFtI/vu0C5NpDb2B5Sc68oNW0Hj",
+	"TTazGr+b7Y5TbkN2ohrMxclX1oMqihzqQHYOXWO6g2oVNM+31UJIzZTuu4d8Z83+Vp+S5/0vhCLPo2+3",
+	"gAToueDZae0Mu4TZX2w9jhdY3idA2dJMFizjkD2iBiWL+v2JlvbAWovUykpvlqI1pNbPo4p0rB1DG5LF",
+	"L7WretL02E5bD4d1e6BuVdwleHtMvvrdc67pIMd0UVnCwayAGWhz6YZwDWtGFKkr6a9bZ1yyC+MiHQmH",
+	"0Oydt/6Ohdt0+JT6oB2uO2NsaeASUvDU6504AR76ZnUcHHZCy55pLCeAwwwK4xwixAJ0fSihaGXWylyR",
+	"lWj6v3WU+yOmRH7OsvFY8h3U+w/1HcKz9HVyX1dVeKCwvhkltDm2GRlAtCVY3S+Hcs1n9HvE1xm2Mnxg",
+	"0HebQR9uxpjrk05HlRzplVNGiVlJZkpip4woMZMsFJkxJOfr5MD3uiLI73mfvPW1RMRVY8WlEHo7pbVi",
+	"gxfkh0ymQpqdNBxmDZUqva+2/VYXwREusZUUZ3XZuFBomfGMaooAeLWiRFL+PZbtb79/DrWEJC8YlxA2",
+	"VVEoSgF0YCnPuPMWmQKzeiWWpDnQfNcGXsHwvgm1IiUrF3VBW1MhTGluDuJODAwjfVnk3k3gDATbgb33",
+	"uv5Wmd0fCaEPYVHevuPQDy166bBzay/je3AEHjaTHXtbuD6E53AbIrARJkWoursZN8N7bmOpg7Gp64ot",
+	"lz8FiOoQsWhaT0gBW7vUvKxpAdcXCeWeOx5hYi5JasVW6OBL34gTaqNY5/fQCfX2Q2+/Yyf68Qqw9jGp",
+	"FRdsthDi+TrIWpACipdU1xLzNuyHUVTZv7tGb5CVfB+xbMJmqO8ifhngJF40NNw8nhNK0hilo1k0kJVG",
+	"ts4w5uXh8cljI0LZOYKVmcdUoySlwjsEBoAV/+5X/4aQFV0HbxhasdXtEFMhvCJ9z+AVmw2/+lpg31M7",
+	"P/BsXBHagEmZUWpTk3pNrQF1ThUJEzRixsmGGzc71O1Qj7KROHoBC7yHRWHHMIC3C/Z0sJtboze38d+D",
+	"xT6e6dFLvQZfQRCzbSVb/gMyixSbS5YJjCXzuPXh7h/AIrkuzvntz6kP7Pr2ISVc6VjbyVhubDKcjVCc",
+	"l7/mmhfhedbi+sT8CZYpyVJWaoZowfY3Wmo+p6u07DvNUN5u2RoMNJZvZonzHshYq+E7dljNeuZLc2Xj",
+	"+hKWdMaoZPJLrauDWi9Yqe2aTPa/eWJWEYtvxGp4nFgMWZKLlOaTZFLLfLI/+QGX6eX+zs4PmSgoL1/u",
+	"/1AJqV9Oksk5lZzOcuQJfNqKAJlAWwsBUSwdz4solj+XHIxKDr52AjcWqdtt/PPuP+9O+tZ9qSn58vHj",
+	"h+ajSPDJZKF11fvsrjJ5O7TVaTJhZV0Y+tpPzP/gAvzyiaf9DwNRD7gbXSKBJJTMqGJNiEcQsNdrQhQU",
+	"SjxljHRX1n/ffdBv5qBc/phzxYivQJOHBbxcO/atycsnL//fAC9VTXwpXAEA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
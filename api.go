@@ -1,50 +1,70 @@
-//go:generate go tool oapi-codegen -config cfg.yaml https://raw.githubusercontent.com/opsminded/spec/refs/heads/main/openapi.json
+//go:generate go tool oapi-codegen -config cfg.yaml openapi.json
 package api
 
 import (
 	"context"
 	"errors"
-	"log"
+	"log/slog"
+	"os"
+	"strings"
+	"sync"
 
 	"github.com/opsminded/graphlib/v2"
 	"github.com/opsminded/service"
 )
 
 type API struct {
-	service *service.Service
+	mu          sync.RWMutex
+	logger      *slog.Logger
+	graph       *graphlib.Graph
+	service     *service.Service
+	catalog     *catalog
+	attributes  *attributeStore
+	loop        *healthLoop
+	events      *eventBroker
+	webhooks    *webhookDispatcher
+	history     *healthHistory
+	propagation *propagationStore
+	watch       *healthWatch
+	// carried keeps the last checks a rebuild would otherwise reset
+	carried map[string]carriedCheck
+	// version changes with every vertex or edge added or removed
+	version uint64
+	related *dependentsCache
+	impact  *impactCache
 }
 
 var _ StrictServerInterface = (*API)(nil)
 
-func New(s *service.Service) StrictServerInterface {
-	return &API{
-		service: s,
-	}
-}
-
-func (api *API) Summary(ctx context.Context, request SummaryRequestObject) (SummaryResponseObject, error) {
-	sum := api.service.Summary()
-	summary := Summary{
-		TotalEdges:        sum.TotalEdges,
-		TotalVertices:     sum.TotalVertices,
-		UnhealthyVertices: []Vertex{},
-	}
-
-	for _, v := range sum.UnhealthyVertices {
-		vertex := Vertex{
-			Key:     v.Key,
-			Label:   v.Label,
-			Class:   v.Class,
-			Healthy: v.Healthy,
-		}
-		summary.UnhealthyVertices = append(summary.UnhealthyVertices, vertex)
-	}
-
-	return Summary200JSONResponse(summary), nil
+// New returns an API serving an empty graph. The graph is owned by the API:
+// populate it with AddVertex and AddEdge or through the HTTP operations.
+// Without a logger, warnings and errors go to stdout as graphlib does.
+func New(logger *slog.Logger) *API {
+	if logger == nil {
+		logger = slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelWarn}))
+	}
+	g := graphlib.NewSoAGraph(logger)
+	api := &API{
+		logger:      logger,
+		graph:       g,
+		service:     service.New(g),
+		catalog:     newCatalog(),
+		attributes:  newAttributeStore(),
+		events:      newEventBroker(),
+		history:     newHealthHistory(),
+		propagation: newPropagationStore(),
+		watch:       &healthWatch{},
+		carried:     make(map[string]carriedCheck),
+		related:     &dependentsCache{},
+		impact:      &impactCache{},
+	}
+	api.webhooks = newWebhookDispatcher(api)
+	api.events.forward = api.webhooks.enqueue
+	return api
 }
 
 func (api *API) GetVertex(ctx context.Context, request GetVertexRequestObject) (GetVertexResponseObject, error) {
-	p, err := api.service.GetVertex(request.Key)
+	p, err := api.svc().GetVertex(request.Key)
 
 	if errors.As(err, &graphlib.VertexNotFoundErr{}) {
 		nf := NotFoundJSONResponse{Code: 404, Error: err.Error()}
@@ -54,44 +74,8 @@ func (api *API) GetVertex(ctx context.Context, request GetVertexRequestObject) (
 		ise := InternalServerErrorJSONResponse{Code: 500, Error: err.Error()}
 		return GetVertex500JSONResponse{InternalServerErrorJSONResponse: ise}, nil
 	}
-	v := Vertex{
-		Key:     p.Key,
-		Label:   p.Label,
-		Class:   p.Class,
-		Healthy: p.Healthy,
-	}
-	return GetVertex200JSONResponse(v), nil
-}
-
-func (api *API) GetVertexAttributes(ctx context.Context, request GetVertexAttributesRequestObject) (GetVertexAttributesResponseObject, error) {
-	// TODO: Implement this function
-
-	x := VertexAttrubutes_Value{}
-	x.FromVertexAttrubutesValue0("hello")
-
-	y := VertexAttrubutes_Value{}
-	y.FromVertexAttrubutesValue1(234234)
-
-	return GetVertexAttributes200JSONResponse{
-		struct {
-			Description string                 `json:"description"`
-			Type        string                 `json:"type"`
-			Value       VertexAttrubutes_Value `json:"value"`
-		}{
-			Description: "description",
-			Type:        "string",
-			Value:       x,
-		},
-		struct {
-			Description string                 `json:"description"`
-			Type        string                 `json:"type"`
-			Value       VertexAttrubutes_Value `json:"value"`
-		}{
-			Description: "strange number",
-			Type:        "integer",
-			Value:       y,
-		},
-	}, nil
+	view := api.healthView()
+	return GetVertex200JSONResponse(api.toVertex(p, view)), nil
 }
 
 func (api *API) GetVertexDependents(ctx context.Context, request GetVertexDependentsRequestObject) (GetVertexDependentsResponseObject, error) {
@@ -100,7 +84,13 @@ func (api *API) GetVertexDependents(ctx context.Context, request GetVertexDepend
 		pall = *request.Params.All
 	}
 
-	serviceSub, err := api.service.VertexDependents(request.Key, pall)
+	walk, err := newTraversal(request.Params.Depth, request.Params.IncludeClass, request.Params.ExcludeClass, request.Params.StopAtClass)
+	if err != nil {
+		ir := InvalidRequestJSONResponse{Code: 422, Error: err.Error()}
+		return GetVertexDependents422JSONResponse{InvalidRequestJSONResponse: ir}, nil
+	}
+
+	serviceSub, err := api.svc().VertexDependents(request.Key, pall || walk.depth > 0)
 	if errors.As(err, &graphlib.VertexNotFoundErr{}) {
 		nf := NotFoundJSONResponse{Code: 404, Error: err.Error()}
 		return GetVertexDependents404JSONResponse{NotFoundJSONResponse: nf}, nil
@@ -111,37 +101,28 @@ func (api *API) GetVertexDependents(ctx context.Context, request GetVertexDepend
 		return GetVertexDependents500JSONResponse{InternalServerErrorJSONResponse: ise}, nil
 	}
 
+	view := api.healthView()
 	sub := Subgraph{
-		Title: "Dependentes de " + request.Key,
-		All:   pall,
-		Principal: Vertex{
-			Key:     serviceSub.Principal.Key,
-			Label:   serviceSub.Principal.Label,
-			Class:   serviceSub.Principal.Class,
-			Healthy: serviceSub.Principal.Healthy,
-		},
-		Edges:      []Edge{},
-		Vertices:   []Vertex{},
+		Title:      "Dependentes de " + request.Key,
+		All:        pall,
+		Principal:  api.toVertex(serviceSub.Principal, view),
+		Edges:      api.toEdges(serviceSub.SubGraph.Edges),
+		Vertices:   api.toVertices(serviceSub.SubGraph.Vertices, view),
 		Highlights: []Vertex{},
 	}
 
-	for _, e := range serviceSub.SubGraph.Edges {
-		edge := Edge{
-			Key:    e.Key,
-			Source: e.Source,
-			Target: e.Target,
-		}
-		sub.Edges = append(sub.Edges, edge)
-	}
-	for _, v := range serviceSub.SubGraph.Vertices {
-		vertex := Vertex{
-			Key:     v.Key,
-			Label:   v.Label,
-			Class:   v.Class,
-			Healthy: v.Healthy,
-		}
-		sub.Vertices = append(sub.Vertices, vertex)
+	if request.Params.EdgeClass != nil {
+		sub.Vertices, sub.Edges = filterEdgeClass(request.Key, sub.Vertices, sub.Edges, *request.Params.EdgeClass, true)
+	}
+	if walk.limited() {
+		sub.Vertices, sub.Edges = walk.walk(request.Key, sub.Vertices, sub.Edges, true)
 	}
+
+	if err := api.highlight(&sub, request.Params.Highlight, unhealthyHighlight, request.Key); err != nil {
+		ir := InvalidRequestJSONResponse{Code: 422, Error: err.Error()}
+		return GetVertexDependents422JSONResponse{InvalidRequestJSONResponse: ir}, nil
+	}
+
 	return GetVertexDependents200JSONResponse(sub), nil
 }
 
@@ -151,8 +132,13 @@ func (api *API) GetVertexDependencies(ctx context.Context, request GetVertexDepe
 		pall = *request.Params.All
 	}
 
-	log.Println("api GetVertexDependencies", request.Key, pall)
-	serviceSub, err := api.service.VertexDependencies(request.Key, pall)
+	walk, err := newTraversal(request.Params.Depth, request.Params.IncludeClass, request.Params.ExcludeClass, request.Params.StopAtClass)
+	if err != nil {
+		ir := InvalidRequestJSONResponse{Code: 422, Error: err.Error()}
+		return GetVertexDependencies422JSONResponse{InvalidRequestJSONResponse: ir}, nil
+	}
+
+	serviceSub, err := api.svc().VertexDependencies(request.Key, pall || walk.depth > 0)
 
 	if errors.As(err, &graphlib.VertexNotFoundErr{}) {
 		nf := NotFoundJSONResponse{Code: 404, Error: err.Error()}
@@ -164,44 +150,33 @@ func (api *API) GetVertexDependencies(ctx context.Context, request GetVertexDepe
 		return GetVertexDependencies500JSONResponse{InternalServerErrorJSONResponse: ise}, nil
 	}
 
+	view := api.healthView()
 	sub := Subgraph{
-		Title: "Dependencias de " + request.Key,
-		All:   pall,
-		Principal: Vertex{
-			Key:     serviceSub.Principal.Key,
-			Label:   serviceSub.Principal.Label,
-			Class:   serviceSub.Principal.Class,
-			Healthy: serviceSub.Principal.Healthy,
-		},
-		Edges:      []Edge{},
-		Vertices:   []Vertex{},
+		Title:      "Dependencias de " + request.Key,
+		All:        pall,
+		Principal:  api.toVertex(serviceSub.Principal, view),
+		Edges:      api.toEdges(serviceSub.SubGraph.Edges),
+		Vertices:   api.toVertices(serviceSub.SubGraph.Vertices, view),
 		Highlights: []Vertex{},
 	}
 
-	for _, e := range serviceSub.SubGraph.Edges {
-		edge := Edge{
-			Key:    e.Key,
-			Source: e.Source,
-			Target: e.Target,
-		}
-		sub.Edges = append(sub.Edges, edge)
+	if request.Params.EdgeClass != nil {
+		sub.Vertices, sub.Edges = filterEdgeClass(request.Key, sub.Vertices, sub.Edges, *request.Params.EdgeClass, false)
+	}
+	if walk.limited() {
+		sub.Vertices, sub.Edges = walk.walk(request.Key, sub.Vertices, sub.Edges, false)
 	}
 
-	for _, v := range serviceSub.SubGraph.Vertices {
-		vertex := Vertex{
-			Key:     v.Key,
-			Label:   v.Label,
-			Class:   v.Class,
-			Healthy: v.Healthy,
-		}
-		sub.Vertices = append(sub.Vertices, vertex)
+	if err := api.highlight(&sub, request.Params.Highlight, unhealthyHighlight, request.Key); err != nil {
+		ir := InvalidRequestJSONResponse{Code: 422, Error: err.Error()}
+		return GetVertexDependencies422JSONResponse{InvalidRequestJSONResponse: ir}, nil
 	}
 
 	return GetVertexDependencies200JSONResponse(sub), nil
 }
 
 func (api *API) GetVertexNeighbors(ctx context.Context, request GetVertexNeighborsRequestObject) (GetVertexNeighborsResponseObject, error) {
-	p, err := api.service.GetVertex(request.Key)
+	p, err := api.svc().GetVertex(request.Key)
 	if errors.As(err, &graphlib.VertexNotFoundErr{}) {
 		nf := NotFoundJSONResponse{Code: 404, Error: err.Error()}
 		return GetVertexNeighbors404JSONResponse{NotFoundJSONResponse: nf}, nil
@@ -211,7 +186,13 @@ func (api *API) GetVertexNeighbors(ctx context.Context, request GetVertexNeighbo
 		return GetVertexNeighbors500JSONResponse{InternalServerErrorJSONResponse: ise}, nil
 	}
 
-	serviceSub, err := api.service.VertexNeighbors(request.Key)
+	walk, err := newTraversal(request.Params.Depth, request.Params.IncludeClass, request.Params.ExcludeClass, request.Params.StopAtClass)
+	if err != nil {
+		ir := InvalidRequestJSONResponse{Code: 422, Error: err.Error()}
+		return GetVertexNeighbors422JSONResponse{InvalidRequestJSONResponse: ir}, nil
+	}
+
+	serviceSub, err := api.svc().VertexNeighbors(request.Key)
 	if errors.As(err, &graphlib.VertexNotFoundErr{}) {
 		nf := NotFoundJSONResponse{Code: 404, Error: err.Error()}
 		return GetVertexNeighbors404JSONResponse{NotFoundJSONResponse: nf}, err
@@ -222,95 +203,85 @@ func (api *API) GetVertexNeighbors(ctx context.Context, request GetVertexNeighbo
 		return GetVertexNeighbors500JSONResponse{InternalServerErrorJSONResponse: ise}, nil
 	}
 
+	view := api.healthView()
 	ss := Subgraph{
-		Principal: Vertex{
-			Key:     p.Key,
-			Label:   p.Label,
-			Class:   p.Class,
-			Healthy: p.Healthy,
-		},
-		Edges:      []Edge{},
-		Vertices:   []Vertex{},
+		Principal:  api.toVertex(p, view),
+		Edges:      api.toEdges(serviceSub.SubGraph.Edges),
+		Vertices:   api.toVertices(serviceSub.SubGraph.Vertices, view),
 		Highlights: []Vertex{},
 	}
 
-	for _, e := range serviceSub.SubGraph.Edges {
-		edge := Edge{
-			Key:    e.Key,
-			Source: e.Source,
-			Target: e.Target,
+	if walk.limited() {
+		ss.Vertices, ss.Edges, err = api.neighborhood(request.Key, walk)
+		if err != nil {
+			ise := InternalServerErrorJSONResponse{Code: 500, Error: err.Error()}
+			return GetVertexNeighbors500JSONResponse{InternalServerErrorJSONResponse: ise}, nil
 		}
-		ss.Edges = append(ss.Edges, edge)
 	}
 
-	for _, v := range serviceSub.SubGraph.Vertices {
-		vertex := Vertex{
-			Key:     v.Key,
-			Label:   v.Label,
-			Class:   v.Class,
-			Healthy: v.Healthy,
-		}
-		ss.Vertices = append(ss.Vertices, vertex)
+	if err := api.highlight(&ss, request.Params.Highlight, unhealthyHighlight, request.Key); err != nil {
+		ir := InvalidRequestJSONResponse{Code: 422, Error: err.Error()}
+		return GetVertexNeighbors422JSONResponse{InvalidRequestJSONResponse: ir}, nil
 	}
 
 	return GetVertexNeighbors200JSONResponse(ss), nil
 }
 
 func (api *API) GetPath(ctx context.Context, request GetPathRequestObject) (GetPathResponseObject, error) {
-	serviceSub, err := api.service.Path(request.Key, request.Target)
+	serviceSub, err := api.svc().Path(request.Key, request.Target)
 
 	if errors.As(err, &graphlib.VertexNotFoundErr{}) {
 		nf := NotFoundJSONResponse{Code: 404, Error: err.Error()}
 		return GetPath404JSONResponse{NotFoundJSONResponse: nf}, nil
 	}
 
+	if errors.As(err, &graphlib.VertexPathErr{}) {
+		np := NoPathJSONResponse{Code: 409, Error: err.Error(), Source: request.Key, Target: request.Target}
+		return GetPath409JSONResponse{NoPathJSONResponse: np}, nil
+	}
+
 	if err != nil {
 		ise := InternalServerErrorJSONResponse{Code: 500, Error: err.Error()}
 		return GetPath500JSONResponse{InternalServerErrorJSONResponse: ise}, nil
 	}
 
+	view := api.healthView()
 	sub := Subgraph{
-		Title: "Caminho entre " + serviceSub.Principal.Label + " e " + request.Target,
-		Principal: Vertex{
-			Key:     serviceSub.Principal.Key,
-			Label:   serviceSub.Principal.Label,
-			Class:   serviceSub.Principal.Class,
-			Healthy: serviceSub.Principal.Healthy,
-		},
-		Edges:      []Edge{},
-		Vertices:   []Vertex{},
+		Title:      "Caminho entre " + serviceSub.Principal.Label + " e " + request.Target,
+		Principal:  api.toVertex(serviceSub.Principal, view),
+		Edges:      api.toEdges(serviceSub.SubGraph.Edges),
+		Vertices:   api.toVertices(serviceSub.SubGraph.Vertices, view),
 		Highlights: []Vertex{},
 	}
 
-	for _, e := range serviceSub.SubGraph.Edges {
-		edge := Edge{
-			Key:    e.Key,
-			Source: e.Source,
-			Target: e.Target,
-		}
-		sub.Edges = append(sub.Edges, edge)
+	if err := api.highlight(&sub, request.Params.Highlight, pathHighlight, request.Key, request.Target); err != nil {
+		ir := InvalidRequestJSONResponse{Code: 422, Error: err.Error()}
+		return GetPath422JSONResponse{InvalidRequestJSONResponse: ir}, nil
 	}
 
-	for _, v := range serviceSub.SubGraph.Vertices {
-		vertex := Vertex{
-			Key:     v.Key,
-			Label:   v.Label,
-			Class:   v.Class,
-			Healthy: v.Healthy,
-		}
-		sub.Vertices = append(sub.Vertices, vertex)
-	}
-
-	return GetPath200JSONResponse(sub), nil
+	return GetPath200JSONResponse(api.orderedPath(sub, request.Key, request.Target)), nil
 }
 
 func (api *API) ClearHealthStatus(ctx context.Context, request ClearHealthStatusRequestObject) (ClearHealthStatusResponseObject, error) {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	unhealthy := api.service.Summary().UnhealthyVertices
 	api.service.ClearGraphHealthyStatus()
+	cleared := healthReport{status: Healthy, reason: "health status cleared"}
+	for _, v := range unhealthy {
+		api.history.report(v.Key, false, cleared, callerName(ctx))
+	}
+	for _, k := range api.history.degraded() {
+		api.history.report(k, true, cleared, callerName(ctx))
+	}
+	api.announceHealthLocked()
 	return ClearHealthStatus200Response{}, nil
 }
 
 func (api *API) MarkVertexHealthy(ctx context.Context, request MarkVertexHealthyRequestObject) (MarkVertexHealthyResponseObject, error) {
-	err := api.service.SetVertexHealth(request.Key, true)
+	r := healthReport{status: Healthy, reason: reason(request.Params.Reason)}
+	err := api.setHealth(request.Key, r, callerName(ctx))
 	if errors.As(err, &graphlib.VertexNotFoundErr{}) {
 		nf := NotFoundJSONResponse{Code: 404, Error: err.Error()}
 		return MarkVertexHealthy404JSONResponse{NotFoundJSONResponse: nf}, nil
@@ -323,7 +294,18 @@ func (api *API) MarkVertexHealthy(ctx context.Context, request MarkVertexHealthy
 }
 
 func (api *API) MarkVertexUnhealthy(ctx context.Context, request MarkVertexUnhealthyRequestObject) (MarkVertexUnhealthyResponseObject, error) {
-	err := api.service.SetVertexHealth(request.Key, false)
+	body := request.JSONBody
+	if request.TextBody != nil {
+		text := strings.TrimSpace(string(*request.TextBody))
+		body = &MarkVertexUnhealthyJSONRequestBody{Reason: &text}
+	}
+	r, err := unhealthyReport(body, reason(request.Params.Reason))
+	if err != nil {
+		ir := InvalidRequestJSONResponse{Code: 422, Error: err.Error()}
+		return MarkVertexUnhealthy422JSONResponse{InvalidRequestJSONResponse: ir}, nil
+	}
+
+	err = api.setHealth(request.Key, r, callerName(ctx))
 
 	if errors.As(err, &graphlib.VertexNotFoundErr{}) {
 		nf := NotFoundJSONResponse{Code: 404, Error: err.Error()}
@@ -0,0 +1,209 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/opsminded/graphlib/v2"
+)
+
+type attributeStore struct {
+	mu    sync.RWMutex
+	items map[string]VertexAttrubutes
+}
+
+func newAttributeStore() *attributeStore {
+	return &attributeStore{
+		items: make(map[string]VertexAttrubutes),
+	}
+}
+
+func (s *attributeStore) get(key string) VertexAttrubutes {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.copy(key)
+}
+
+func (s *attributeStore) replace(key string, attrs VertexAttrubutes) VertexAttrubutes {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.items[key] = append(VertexAttrubutes{}, attrs...)
+	return s.copy(key)
+}
+
+func (s *attributeStore) merge(key string, attrs VertexAttrubutes) VertexAttrubutes {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	current := s.items[key]
+	for _, a := range attrs {
+		replaced := false
+		for i := range current {
+			if current[i].Description == a.Description {
+				current[i] = a
+				replaced = true
+				break
+			}
+		}
+		if !replaced {
+			current = append(current, a)
+		}
+	}
+	s.items[key] = current
+
+	return s.copy(key)
+}
+
+func (s *attributeStore) remove(key string, descriptions []string) VertexAttrubutes {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(descriptions) == 0 {
+		delete(s.items, key)
+		return VertexAttrubutes{}
+	}
+
+	drop := make(map[string]struct{}, len(descriptions))
+	for _, d := range descriptions {
+		drop[d] = struct{}{}
+	}
+
+	kept := VertexAttrubutes{}
+	for _, a := range s.items[key] {
+		if _, ok := drop[a.Description]; !ok {
+			kept = append(kept, a)
+		}
+	}
+	s.items[key] = kept
+
+	return s.copy(key)
+}
+
+func (s *attributeStore) copy(key string) VertexAttrubutes {
+	return append(VertexAttrubutes{}, s.items[key]...)
+}
+
+// attributeText returns the value of an attribute as it would be typed in a
+// query string: strings and links unquoted, integers and booleans as JSON.
+func attributeText(a VertexAttribute) string {
+	if s, err := a.Value.AsVertexAttrubutesValue0(); err == nil && (a.Type == "string" || a.Type == "link") {
+		return s
+	}
+	b, err := a.Value.MarshalJSON()
+	if err != nil {
+		return ""
+	}
+	return string(b)
+}
+
+func validateAttributes(attrs VertexAttrubutes) error {
+	seen := make(map[string]struct{}, len(attrs))
+	for _, a := range attrs {
+		if a.Description == "" {
+			return errors.New("attribute description is required")
+		}
+		if _, dup := seen[a.Description]; dup {
+			return fmt.Errorf("attribute %q is duplicated", a.Description)
+		}
+		seen[a.Description] = struct{}{}
+
+		var err error
+		switch a.Type {
+		case "string", "link":
+			_, err = a.Value.AsVertexAttrubutesValue0()
+		case "integer":
+			_, err = a.Value.AsVertexAttrubutesValue1()
+		case "boolean":
+			_, err = a.Value.AsVertexAttrubutesValue2()
+		default:
+			return fmt.Errorf("attribute %q has unknown type %q", a.Description, a.Type)
+		}
+		if err != nil {
+			return fmt.Errorf("attribute %q value is not a valid %s", a.Description, a.Type)
+		}
+	}
+	return nil
+}
+
+func (api *API) GetVertexAttributes(ctx context.Context, request GetVertexAttributesRequestObject) (GetVertexAttributesResponseObject, error) {
+	_, err := api.svc().GetVertex(request.Key)
+	if errors.As(err, &graphlib.VertexNotFoundErr{}) {
+		nf := NotFoundJSONResponse{Code: 404, Error: err.Error()}
+		return GetVertexAttributes404JSONResponse{NotFoundJSONResponse: nf}, nil
+	}
+	if err != nil {
+		ise := InternalServerErrorJSONResponse{Code: 500, Error: err.Error()}
+		return GetVertexAttributes500JSONResponse{InternalServerErrorJSONResponse: ise}, nil
+	}
+
+	return GetVertexAttributes200JSONResponse(api.attributes.get(request.Key)), nil
+}
+
+func (api *API) ReplaceVertexAttributes(ctx context.Context, request ReplaceVertexAttributesRequestObject) (ReplaceVertexAttributesResponseObject, error) {
+	_, err := api.svc().GetVertex(request.Key)
+	if errors.As(err, &graphlib.VertexNotFoundErr{}) {
+		nf := NotFoundJSONResponse{Code: 404, Error: err.Error()}
+		return ReplaceVertexAttributes404JSONResponse{NotFoundJSONResponse: nf}, nil
+	}
+	if err != nil {
+		ise := InternalServerErrorJSONResponse{Code: 500, Error: err.Error()}
+		return ReplaceVertexAttributes500JSONResponse{InternalServerErrorJSONResponse: ise}, nil
+	}
+
+	if request.Body == nil {
+		ir := InvalidRequestJSONResponse{Code: 422, Error: "request body is required"}
+		return ReplaceVertexAttributes422JSONResponse{InvalidRequestJSONResponse: ir}, nil
+	}
+	if err := validateAttributes(*request.Body); err != nil {
+		ir := InvalidRequestJSONResponse{Code: 422, Error: err.Error()}
+		return ReplaceVertexAttributes422JSONResponse{InvalidRequestJSONResponse: ir}, nil
+	}
+
+	return ReplaceVertexAttributes200JSONResponse(api.attributes.replace(request.Key, *request.Body)), nil
+}
+
+func (api *API) UpdateVertexAttributes(ctx context.Context, request UpdateVertexAttributesRequestObject) (UpdateVertexAttributesResponseObject, error) {
+	_, err := api.svc().GetVertex(request.Key)
+	if errors.As(err, &graphlib.VertexNotFoundErr{}) {
+		nf := NotFoundJSONResponse{Code: 404, Error: err.Error()}
+		return UpdateVertexAttributes404JSONResponse{NotFoundJSONResponse: nf}, nil
+	}
+	if err != nil {
+		ise := InternalServerErrorJSONResponse{Code: 500, Error: err.Error()}
+		return UpdateVertexAttributes500JSONResponse{InternalServerErrorJSONResponse: ise}, nil
+	}
+
+	if request.Body == nil {
+		ir := InvalidRequestJSONResponse{Code: 422, Error: "request body is required"}
+		return UpdateVertexAttributes422JSONResponse{InvalidRequestJSONResponse: ir}, nil
+	}
+	if err := validateAttributes(*request.Body); err != nil {
+		ir := InvalidRequestJSONResponse{Code: 422, Error: err.Error()}
+		return UpdateVertexAttributes422JSONResponse{InvalidRequestJSONResponse: ir}, nil
+	}
+
+	return UpdateVertexAttributes200JSONResponse(api.attributes.merge(request.Key, *request.Body)), nil
+}
+
+func (api *API) DeleteVertexAttributes(ctx context.Context, request DeleteVertexAttributesRequestObject) (DeleteVertexAttributesResponseObject, error) {
+	_, err := api.svc().GetVertex(request.Key)
+	if errors.As(err, &graphlib.VertexNotFoundErr{}) {
+		nf := NotFoundJSONResponse{Code: 404, Error: err.Error()}
+		return DeleteVertexAttributes404JSONResponse{NotFoundJSONResponse: nf}, nil
+	}
+	if err != nil {
+		ise := InternalServerErrorJSONResponse{Code: 500, Error: err.Error()}
+		return DeleteVertexAttributes500JSONResponse{InternalServerErrorJSONResponse: ise}, nil
+	}
+
+	var descriptions []string
+	if request.Params.Description != nil {
+		descriptions = *request.Params.Description
+	}
+
+	return DeleteVertexAttributes200JSONResponse(api.attributes.remove(request.Key, descriptions)), nil
+}
@@ -0,0 +1,292 @@
+package api
+
+import (
+	"context"
+	"crypto"
+	"crypto/hmac"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+// Identity is the authenticated caller of an operation.
+type Identity struct {
+	Subject string
+	Scopes  []string
+	Roles   []string
+}
+
+type identityKey struct{}
+
+// IdentityFromContext returns the caller identity set by the authentication
+// middleware.
+func IdentityFromContext(ctx context.Context) (Identity, bool) {
+	id, ok := ctx.Value(identityKey{}).(Identity)
+	return id, ok
+}
+
+// AuthOptions configures the bearer token authentication.
+type AuthOptions struct {
+	// TokensFile is a JSON list of static tokens:
+	// [{"token": "...", "subject": "grafana", "scopes": ["read"], "roles": []}]
+	TokensFile string
+	// JWKSFile holds the keys used to verify HS256 (kty "oct") and RS256
+	// (kty "RSA") JWTs.
+	JWKSFile string
+	// Issuer and Audience, when set, must match the iss and aud claims.
+	Issuer   string
+	Audience string
+	// AllowNoExpiry accepts JWTs without an exp claim, which never expire.
+	// They are rejected by default.
+	AllowNoExpiry bool
+}
+
+type staticToken struct {
+	Token   string   `json:"token"`
+	Subject string   `json:"subject"`
+	Scopes  []string `json:"scopes"`
+	Roles   []string `json:"roles"`
+}
+
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	K   string `json:"k"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+type jwtKey struct {
+	kid    string
+	secret []byte
+	public *rsa.PublicKey
+}
+
+// Authenticator validates bearer tokens.
+type Authenticator struct {
+	tokens        map[[sha256.Size]byte]Identity
+	keys          []jwtKey
+	issuer        string
+	audience      string
+	allowNoExpiry bool
+	now           func() time.Time
+}
+
+func NewAuthenticator(opts AuthOptions) (*Authenticator, error) {
+	a := &Authenticator{
+		tokens:        make(map[[sha256.Size]byte]Identity),
+		issuer:        opts.Issuer,
+		audience:      opts.Audience,
+		allowNoExpiry: opts.AllowNoExpiry,
+		now:           time.Now,
+	}
+
+	if opts.TokensFile != "" {
+		b, err := os.ReadFile(opts.TokensFile)
+		if err != nil {
+			return nil, err
+		}
+		var tokens []staticToken
+		if err := json.Unmarshal(b, &tokens); err != nil {
+			return nil, fmt.Errorf("%s: %w", opts.TokensFile, err)
+		}
+		for _, t := range tokens {
+			if t.Token == "" {
+				return nil, fmt.Errorf("%s: empty token for subject %q", opts.TokensFile, t.Subject)
+			}
+			// indexed by hash so the lookup does not leak the token by timing
+			a.tokens[sha256.Sum256([]byte(t.Token))] = Identity{Subject: t.Subject, Scopes: t.Scopes, Roles: t.Roles}
+		}
+	}
+
+	if opts.JWKSFile != "" {
+		b, err := os.ReadFile(opts.JWKSFile)
+		if err != nil {
+			return nil, err
+		}
+		var set struct {
+			Keys []jwk `json:"keys"`
+		}
+		if err := json.Unmarshal(b, &set); err != nil {
+			return nil, fmt.Errorf("%s: %w", opts.JWKSFile, err)
+		}
+		for _, k := range set.Keys {
+			key, err := parseJWK(k)
+			if err != nil {
+				return nil, fmt.Errorf("%s: key %q: %w", opts.JWKSFile, k.Kid, err)
+			}
+			a.keys = append(a.keys, key)
+		}
+	}
+
+	return a, nil
+}
+
+func parseJWK(k jwk) (jwtKey, error) {
+	switch k.Kty {
+	case "oct":
+		secret, err := base64.RawURLEncoding.DecodeString(k.K)
+		if err != nil {
+			return jwtKey{}, err
+		}
+		return jwtKey{kid: k.Kid, secret: secret}, nil
+	case "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			return jwtKey{}, err
+		}
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			return jwtKey{}, err
+		}
+		pub := &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}
+		return jwtKey{kid: k.Kid, public: pub}, nil
+	}
+	return jwtKey{}, fmt.Errorf("unsupported key type %q", k.Kty)
+}
+
+// Authenticate returns the identity behind a bearer token.
+func (a *Authenticator) Authenticate(token string) (Identity, error) {
+	if id, ok := a.tokens[sha256.Sum256([]byte(token))]; ok {
+		return id, nil
+	}
+	if strings.Count(token, ".") == 2 && len(a.keys) > 0 {
+		return a.verifyJWT(token)
+	}
+	return Identity{}, errors.New("invalid token")
+}
+
+func (a *Authenticator) verifyJWT(token string) (Identity, error) {
+	parts := strings.Split(token, ".")
+
+	var header struct {
+		Alg string `json:"alg"`
+		Kid string `json:"kid"`
+	}
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return Identity{}, errors.New("malformed token header")
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return Identity{}, errors.New("malformed token signature")
+	}
+
+	signed := []byte(parts[0] + "." + parts[1])
+	verified := false
+	for _, k := range a.keys {
+		if header.Kid != "" && k.kid != header.Kid {
+			continue
+		}
+		switch {
+		case header.Alg == "HS256" && k.secret != nil:
+			mac := hmac.New(sha256.New, k.secret)
+			mac.Write(signed)
+			verified = hmac.Equal(sig, mac.Sum(nil))
+		case header.Alg == "RS256" && k.public != nil:
+			sum := sha256.Sum256(signed)
+			verified = rsa.VerifyPKCS1v15(k.public, crypto.SHA256, sum[:], sig) == nil
+		}
+		if verified {
+			break
+		}
+	}
+	if !verified {
+		return Identity{}, errors.New("invalid token signature")
+	}
+
+	var claims struct {
+		Sub   string          `json:"sub"`
+		Iss   string          `json:"iss"`
+		Aud   json.RawMessage `json:"aud"`
+		Exp   *float64        `json:"exp"`
+		Nbf   *float64        `json:"nbf"`
+		Scope string          `json:"scope"`
+		Scp   []string        `json:"scp"`
+		Roles []string        `json:"roles"`
+	}
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return Identity{}, errors.New("malformed token claims")
+	}
+
+	now := float64(a.now().Unix())
+	if claims.Exp == nil && !a.allowNoExpiry {
+		return Identity{}, errors.New("token has no expiry")
+	}
+	if claims.Exp != nil && now >= *claims.Exp {
+		return Identity{}, errors.New("token expired")
+	}
+	if claims.Nbf != nil && now < *claims.Nbf {
+		return Identity{}, errors.New("token not yet valid")
+	}
+	if a.issuer != "" && claims.Iss != a.issuer {
+		return Identity{}, errors.New("unexpected token issuer")
+	}
+	if a.audience != "" && !hasAudience(claims.Aud, a.audience) {
+		return Identity{}, errors.New("unexpected token audience")
+	}
+
+	scopes := append(strings.Fields(claims.Scope), claims.Scp...)
+	return Identity{Subject: claims.Sub, Scopes: scopes, Roles: claims.Roles}, nil
+}
+
+func decodeSegment(seg string, v interface{}) error {
+	b, err := base64.RawURLEncoding.DecodeString(seg)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, v)
+}
+
+// hasAudience accepts the aud claim both as a string and as a list.
+func hasAudience(raw json.RawMessage, audience string) bool {
+	var one string
+	if json.Unmarshal(raw, &one) == nil {
+		return one == audience
+	}
+	var many []string
+	if json.Unmarshal(raw, &many) == nil {
+		for _, a := range many {
+			if a == audience {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func writeUnauthorized(w http.ResponseWriter, msg string) {
+	w.Header().Set("WWW-Authenticate", `Bearer realm="api"`)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusUnauthorized)
+	json.NewEncoder(w).Encode(UnauthorizedJSONResponse{Code: 401, Error: msg})
+}
+
+// Middleware rejects requests without a valid bearer token with the 401 body
+// of the spec and stores the caller identity in the context.
+func (a *Authenticator) Middleware() StrictMiddlewareFunc {
+	return func(f StrictHandlerFunc, operationID string) StrictHandlerFunc {
+		return func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+			scheme, token, ok := strings.Cut(r.Header.Get("Authorization"), " ")
+			if !ok || !strings.EqualFold(scheme, "Bearer") || token == "" {
+				writeUnauthorized(w, "missing bearer token")
+				return nil, nil
+			}
+
+			id, err := a.Authenticate(strings.TrimSpace(token))
+			if err != nil {
+				writeUnauthorized(w, err.Error())
+				return nil, nil
+			}
+
+			return f(context.WithValue(ctx, identityKey{}, id), w, r, request)
+		}
+	}
+}
@@ -0,0 +1,207 @@
+package api
+
+import (
+	"crypto"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+var testNow = time.Date(2025, 7, 21, 17, 0, 0, 0, time.UTC)
+
+func segment(t *testing.T, v any) string {
+	t.Helper()
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func signHS256(t *testing.T, secret []byte, header, claims map[string]any) string {
+	t.Helper()
+	signed := segment(t, header) + "." + segment(t, claims)
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(signed))
+	return signed + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func signRS256(t *testing.T, key *rsa.PrivateKey, header, claims map[string]any) string {
+	t.Helper()
+	signed := segment(t, header) + "." + segment(t, claims)
+	sum := sha256.Sum256([]byte(signed))
+	sig, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, sum[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signed + "." + base64.RawURLEncoding.EncodeToString(sig)
+}
+
+func writeJSON(t *testing.T, dir, name string, v any) string {
+	t.Helper()
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, b, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestAuthenticate(t *testing.T) {
+	secret := []byte("first-secret-of-at-least-32-bytes")
+	other := []byte("second-secret-of-at-least-32-byte")
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	strangerKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dir := t.TempDir()
+	tokens := writeJSON(t, dir, "tokens.json", []staticToken{
+		{Token: "static-grafana", Subject: "grafana", Scopes: []string{"read"}},
+	})
+	jwks := writeJSON(t, dir, "jwks.json", map[string]any{"keys": []jwk{
+		{Kty: "oct", Kid: "hs-1", K: base64.RawURLEncoding.EncodeToString(secret)},
+		{Kty: "oct", Kid: "hs-2", K: base64.RawURLEncoding.EncodeToString(other)},
+		{
+			Kty: "RSA",
+			Kid: "rs-1",
+			N:   base64.RawURLEncoding.EncodeToString(rsaKey.N.Bytes()),
+			E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(rsaKey.E)).Bytes()),
+		},
+	}})
+
+	a, err := NewAuthenticator(AuthOptions{TokensFile: tokens, JWKSFile: jwks, Issuer: "opsmind", Audience: "api"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	a.now = func() time.Time { return testNow }
+
+	claims := func(extra map[string]any) map[string]any {
+		c := map[string]any{
+			"sub":   "noc",
+			"iss":   "opsmind",
+			"aud":   "api",
+			"exp":   testNow.Add(time.Hour).Unix(),
+			"scope": "read health",
+		}
+		// a nil value drops the claim
+		for k, v := range extra {
+			if v == nil {
+				delete(c, k)
+				continue
+			}
+			c[k] = v
+		}
+		return c
+	}
+	hs := map[string]any{"alg": "HS256", "kid": "hs-1"}
+
+	cases := []struct {
+		name    string
+		token   string
+		subject string
+		scopes  []string
+		err     string
+	}{
+		{name: "static token", token: "static-grafana", subject: "grafana", scopes: []string{"read"}},
+		{name: "unknown static token", token: "static-other", err: "invalid token"},
+		{name: "hs256", token: signHS256(t, secret, hs, claims(nil)), subject: "noc", scopes: []string{"read", "health"}},
+		{
+			name:    "scp claim",
+			token:   signHS256(t, secret, hs, claims(map[string]any{"scope": "", "scp": []string{"admin"}})),
+			subject: "noc",
+			scopes:  []string{"admin"},
+		},
+		{name: "kid picks the second key", token: signHS256(t, other, map[string]any{"alg": "HS256", "kid": "hs-2"}, claims(nil)), subject: "noc", scopes: []string{"read", "health"}},
+		{name: "no kid tries every key", token: signHS256(t, other, map[string]any{"alg": "HS256"}, claims(nil)), subject: "noc", scopes: []string{"read", "health"}},
+		{name: "kid of another key", token: signHS256(t, other, hs, claims(nil)), err: "invalid token signature"},
+		{name: "unknown kid", token: signHS256(t, secret, map[string]any{"alg": "HS256", "kid": "gone"}, claims(nil)), err: "invalid token signature"},
+		{name: "wrong key", token: signHS256(t, []byte("not-a-configured-secret-at-all!!"), hs, claims(nil)), err: "invalid token signature"},
+		{name: "alg none", token: segment(t, map[string]any{"alg": "none"}) + "." + segment(t, claims(nil)) + ".", err: "invalid token signature"},
+		{name: "rs256", token: signRS256(t, rsaKey, map[string]any{"alg": "RS256", "kid": "rs-1"}, claims(nil)), subject: "noc", scopes: []string{"read", "health"}},
+		{name: "rs256 wrong key", token: signRS256(t, strangerKey, map[string]any{"alg": "RS256", "kid": "rs-1"}, claims(nil)), err: "invalid token signature"},
+		{name: "rs256 alg on a secret", token: signHS256(t, secret, map[string]any{"alg": "RS256", "kid": "hs-1"}, claims(nil)), err: "invalid token signature"},
+		{name: "expired", token: signHS256(t, secret, hs, claims(map[string]any{"exp": testNow.Add(-time.Second).Unix()})), err: "token expired"},
+		{name: "expires now", token: signHS256(t, secret, hs, claims(map[string]any{"exp": testNow.Unix()})), err: "token expired"},
+		{name: "not yet valid", token: signHS256(t, secret, hs, claims(map[string]any{"nbf": testNow.Add(time.Minute).Unix()})), err: "token not yet valid"},
+		{name: "wrong issuer", token: signHS256(t, secret, hs, claims(map[string]any{"iss": "someone"})), err: "unexpected token issuer"},
+		{name: "wrong audience", token: signHS256(t, secret, hs, claims(map[string]any{"aud": "grafana"})), err: "unexpected token audience"},
+		{name: "audience list", token: signHS256(t, secret, hs, claims(map[string]any{"aud": []string{"grafana", "api"}})), subject: "noc", scopes: []string{"read", "health"}},
+		{name: "audience list without api", token: signHS256(t, secret, hs, claims(map[string]any{"aud": []string{"grafana"}})), err: "unexpected token audience"},
+		{name: "malformed header", token: "x.y.z", err: "malformed token header"},
+		{name: "no expiry", token: signHS256(t, secret, hs, claims(map[string]any{"exp": nil})), err: "token has no expiry"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			id, err := a.Authenticate(c.token)
+			if c.err != "" {
+				if err == nil || err.Error() != c.err {
+					t.Fatalf("got error %v, want %q", err, c.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if id.Subject != c.subject {
+				t.Errorf("got subject %q, want %q", id.Subject, c.subject)
+			}
+			if len(id.Scopes) != len(c.scopes) {
+				t.Fatalf("got scopes %v, want %v", id.Scopes, c.scopes)
+			}
+			for i := range c.scopes {
+				if id.Scopes[i] != c.scopes[i] {
+					t.Errorf("got scopes %v, want %v", id.Scopes, c.scopes)
+				}
+			}
+		})
+	}
+}
+
+func TestTamperedClaims(t *testing.T) {
+	secret := []byte("first-secret-of-at-least-32-bytes")
+	dir := t.TempDir()
+	jwks := writeJSON(t, dir, "jwks.json", map[string]any{"keys": []jwk{
+		{Kty: "oct", K: base64.RawURLEncoding.EncodeToString(secret)},
+	}})
+	a, err := NewAuthenticator(AuthOptions{JWKSFile: jwks, AllowNoExpiry: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// without exp, which is allowed here
+	token := signHS256(t, secret, map[string]any{"alg": "HS256"}, map[string]any{"sub": "noc", "scope": "read"})
+	if _, err := a.Authenticate(token); err != nil {
+		t.Fatalf("untouched token: %v", err)
+	}
+
+	parts := strings.Split(token, ".")
+	forged := parts[0] + "." + segment(t, map[string]any{"sub": "noc", "scope": "admin"}) + "." + parts[2]
+	if _, err := a.Authenticate(forged); err == nil {
+		t.Fatal("token with changed claims was accepted")
+	}
+}
+
+func TestNewAuthenticatorRejectsEmptyStaticToken(t *testing.T) {
+	tokens := writeJSON(t, t.TempDir(), "tokens.json", []staticToken{{Token: "", Subject: "grafana"}})
+	if _, err := NewAuthenticator(AuthOptions{TokensFile: tokens}); err == nil {
+		t.Fatal("empty static token was accepted")
+	}
+}
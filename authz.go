@@ -0,0 +1,136 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+)
+
+// readOperations are the operations that do not change the graph.
+var readOperations = []string{
+	"Summary",
+	"GetVertex",
+	"GetVertexAttributes",
+	"GetVertexHistory",
+	"GetVertexImpact",
+	"GetVertexDependencies",
+	"GetVertexDependents",
+	"GetVertexNeighbors",
+	"GetVertexRootCause",
+	"GetPath",
+	"GetPaths",
+	"ListVertices",
+	"ListEdges",
+	"GetEdge",
+	"ExportGraph",
+	"Simulate",
+	"GetEvents",
+	"GetCycles",
+	"GetSinglePointsOfFailure",
+	"GetCriticalVertices",
+	"GetOrphans",
+	"GetVertexStartupOrder",
+	"GetStartupOrder",
+	"GetVertexPropagation",
+	"ListClassPropagation",
+	"ListRedundancyGroups",
+	"GetRedundancyGroup",
+}
+
+// healthOperations are the operations used by monitoring integrations.
+var healthOperations = []string{
+	"MarkVertexHealthy",
+	"MarkVertexUnhealthy",
+}
+
+// Policy maps token scopes and roles to the operation ids they may call.
+// The operation "*" allows every operation.
+type Policy struct {
+	Scopes map[string][]string `json:"scopes"`
+	Roles  map[string][]string `json:"roles"`
+}
+
+// DefaultPolicy lets the "read" scope query the graph, the "health" scope
+// report health as well, and the "admin" scope or role call everything.
+func DefaultPolicy() *Policy {
+	return &Policy{
+		Scopes: map[string][]string{
+			"read":   readOperations,
+			"health": append(append([]string{}, readOperations...), healthOperations...),
+			"admin":  {"*"},
+		},
+		Roles: map[string][]string{
+			"admin": {"*"},
+		},
+	}
+}
+
+// LoadPolicy reads a policy from a JSON file with the same shape as Policy.
+func LoadPolicy(path string) (*Policy, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	p := &Policy{}
+	if err := json.Unmarshal(b, p); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return p, nil
+}
+
+// Allowed reports whether any scope or role of the identity grants the
+// operation.
+func (p *Policy) Allowed(id Identity, operationID string) bool {
+	grants := func(ops []string) bool {
+		for _, op := range ops {
+			if op == "*" || op == operationID {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, s := range id.Scopes {
+		if grants(p.Scopes[s]) {
+			return true
+		}
+	}
+	for _, r := range id.Roles {
+		if grants(p.Roles[r]) {
+			return true
+		}
+	}
+	return false
+}
+
+func writeForbidden(w http.ResponseWriter, msg string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusForbidden)
+	json.NewEncoder(w).Encode(ForbiddenJSONResponse{Code: 403, Error: msg})
+}
+
+// Middleware answers 403 when the caller may not call the operation. It reads
+// the identity set by Authenticator.Middleware, so the authenticator has to
+// run first. NewStrictHandler wraps the handler with each middleware in turn,
+// so the last one in the list runs first: the Authenticator must come after
+// the Policy.
+//
+//	NewStrictHandler(a, []StrictMiddlewareFunc{policy.Middleware(), auth.Middleware()})
+func (p *Policy) Middleware() StrictMiddlewareFunc {
+	return func(f StrictHandlerFunc, operationID string) StrictHandlerFunc {
+		return func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+			id, ok := IdentityFromContext(ctx)
+			if !ok {
+				writeForbidden(w, fmt.Sprintf("operation %q requires an authenticated caller", operationID))
+				return nil, nil
+			}
+			if !p.Allowed(id, operationID) {
+				writeForbidden(w, fmt.Sprintf("operation %q is not allowed for %q", operationID, id.Subject))
+				return nil, nil
+			}
+			return f(ctx, w, r, request)
+		}
+	}
+}
@@ -0,0 +1,140 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestAllowed(t *testing.T) {
+	p := DefaultPolicy()
+
+	cases := []struct {
+		name      string
+		id        Identity
+		operation string
+		want      bool
+	}{
+		{name: "read queries", id: Identity{Scopes: []string{"read"}}, operation: "GetVertex", want: true},
+		{name: "read reports health", id: Identity{Scopes: []string{"read"}}, operation: "MarkVertexUnhealthy"},
+		{name: "read changes the graph", id: Identity{Scopes: []string{"read"}}, operation: "DeleteVertex"},
+		{name: "health queries", id: Identity{Scopes: []string{"health"}}, operation: "ListVertices", want: true},
+		{name: "health reports health", id: Identity{Scopes: []string{"health"}}, operation: "MarkVertexUnhealthy", want: true},
+		{name: "health changes the graph", id: Identity{Scopes: []string{"health"}}, operation: "CreateEdge"},
+		{name: "admin scope", id: Identity{Scopes: []string{"admin"}}, operation: "ImportGraph", want: true},
+		{name: "admin role", id: Identity{Roles: []string{"admin"}}, operation: "DeleteWebhook", want: true},
+		{name: "any scope is enough", id: Identity{Scopes: []string{"unknown", "health"}}, operation: "MarkVertexHealthy", want: true},
+		{name: "role named like a scope", id: Identity{Roles: []string{"read"}}, operation: "GetVertex"},
+		{name: "nothing", id: Identity{Subject: "noc"}, operation: "GetVertex"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := p.Allowed(c.id, c.operation); got != c.want {
+				t.Errorf("got %v, want %v", got, c.want)
+			}
+		})
+	}
+}
+
+func TestLoadPolicy(t *testing.T) {
+	dir := t.TempDir()
+	path := writeJSON(t, dir, "policy.json", map[string]any{
+		"scopes": map[string][]string{"oncall": {"MarkVertexHealthy", "GetVertex"}},
+		"roles":  map[string][]string{"ops": {"*"}},
+	})
+
+	p, err := LoadPolicy(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !p.Allowed(Identity{Scopes: []string{"oncall"}}, "MarkVertexHealthy") {
+		t.Error("oncall may not mark vertices healthy")
+	}
+	if p.Allowed(Identity{Scopes: []string{"oncall"}}, "MarkVertexUnhealthy") {
+		t.Error("oncall may mark vertices unhealthy")
+	}
+	if !p.Allowed(Identity{Roles: []string{"ops"}}, "DeleteVertex") {
+		t.Error("ops may not delete vertices")
+	}
+	// only what the file grants
+	if p.Allowed(Identity{Scopes: []string{"read"}}, "GetVertex") {
+		t.Error("the default scopes leaked into the loaded policy")
+	}
+
+	broken := filepath.Join(dir, "broken.json")
+	if err := os.WriteFile(broken, []byte("{"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadPolicy(broken); err == nil {
+		t.Error("a malformed policy was loaded")
+	}
+	if _, err := LoadPolicy(filepath.Join(dir, "missing.json")); err == nil {
+		t.Error("a missing policy was loaded")
+	}
+}
+
+func TestPolicyMiddleware(t *testing.T) {
+	tokens := writeJSON(t, t.TempDir(), "tokens.json", []staticToken{
+		{Token: "reader-token", Subject: "grafana", Scopes: []string{"read"}},
+		{Token: "admin-token", Subject: "ops", Roles: []string{"admin"}},
+	})
+	auth, err := NewAuthenticator(AuthOptions{TokensFile: tokens})
+	if err != nil {
+		t.Fatal(err)
+	}
+	policy := DefaultPolicy()
+
+	serve := func(mw []StrictMiddlewareFunc, method, path, token string) (int, ForbiddenJSONResponse) {
+		h := HandlerFromMux(NewStrictHandler(testAPI(t, "web>db"), mw), http.NewServeMux())
+		req := httptest.NewRequest(method, path, nil)
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		body := ForbiddenJSONResponse{}
+		// 401 and 403 share the shape
+		json.Unmarshal(rec.Body.Bytes(), &body)
+		return rec.Code, body
+	}
+
+	ordered := []StrictMiddlewareFunc{policy.Middleware(), auth.Middleware()}
+	cases := []struct {
+		name          string
+		method, path  string
+		token         string
+		code          int
+		error         string
+		reversedOrder bool
+	}{
+		{name: "reader queries", method: http.MethodGet, path: "/vertices/db", token: "reader-token", code: 200},
+		{name: "reader deletes", method: http.MethodDelete, path: "/vertices/db", token: "reader-token", code: 403, error: `operation "DeleteVertex" is not allowed for "grafana"`},
+		{name: "admin deletes", method: http.MethodDelete, path: "/vertices/db", token: "admin-token", code: 200},
+		{name: "no token", method: http.MethodGet, path: "/vertices/db", code: 401, error: "missing bearer token"},
+		{name: "unknown token", method: http.MethodGet, path: "/vertices/db", token: "other", code: 401, error: "invalid token"},
+		{
+			name:   "policy after the authenticator",
+			method: http.MethodGet, path: "/vertices/db", token: "reader-token",
+			code: 403, error: `operation "GetVertex" requires an authenticated caller`,
+			reversedOrder: true,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			mw := ordered
+			if c.reversedOrder {
+				mw = []StrictMiddlewareFunc{auth.Middleware(), policy.Middleware()}
+			}
+			code, body := serve(mw, c.method, c.path, c.token)
+			if code != c.code {
+				t.Fatalf("got %d, want %d", code, c.code)
+			}
+			if c.error != "" && (body.Code != c.code || body.Error != c.error) {
+				t.Errorf("got body %+v, want %q", body, c.error)
+			}
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package api
+
+import (
+	"sort"
+	"strings"
+)
+
+// catalog is the authoritative copy of the topology served by the API.
+// graphlib can neither list nor remove elements, so every change is recorded
+// here, and removals rebuild the graph from a changed copy.
+type catalog struct {
+	vertices map[string]vertexRecord
+	edges    map[string]edgeRecord
+}
+
+type vertexRecord struct {
+	Key   string
+	Label string
+	Class string
+}
+
+type edgeRecord struct {
+	Key    string
+	Source string
+	Target string
+	Class  string
+	Label  string
+}
+
+func newCatalog() *catalog {
+	return &catalog{
+		vertices: make(map[string]vertexRecord),
+		edges:    make(map[string]edgeRecord),
+	}
+}
+
+// clone returns a copy of c that can be changed without touching c.
+func (c *catalog) clone() *catalog {
+	next := newCatalog()
+	for k, v := range c.vertices {
+		next.vertices[k] = v
+	}
+	for k, e := range c.edges {
+		next.edges[k] = e
+	}
+	return next
+}
+
+// edgeKeyEscaper escapes the characters that would make edge keys ambiguous
+// with a backslash. Percent-encoding would not survive the path parameters,
+// which are unescaped twice.
+var edgeKeyEscaper = strings.NewReplacer(`\`, `\\`, ">", `\>`)
+
+// edgeKey returns the key of the edge from src to tgt, written src->tgt. A
+// ">" inside a vertex key is escaped, so the only bare one is the separator
+// and no two edges share a key.
+func edgeKey(src, tgt string) string {
+	return edgeKeyEscaper.Replace(src) + "->" + edgeKeyEscaper.Replace(tgt)
+}
+
+func (c *catalog) vertexKeys() []string {
+	keys := make([]string, 0, len(c.vertices))
+	for k := range c.vertices {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func (c *catalog) edgeKeys() []string {
+	keys := make([]string, 0, len(c.edges))
+	for k := range c.edges {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// removeVertex drops the vertex and every edge touching it.
+func (c *catalog) removeVertex(key string) {
+	delete(c.vertices, key)
+	for k, e := range c.edges {
+		if e.Source == key || e.Target == key {
+			delete(c.edges, k)
+		}
+	}
+}
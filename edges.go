@@ -0,0 +1,152 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/opsminded/graphlib/v2"
+)
+
+type edgeNotFoundErr struct {
+	Key string
+}
+
+func (e edgeNotFoundErr) Error() string {
+	return fmt.Sprintf("edge %q not found", e.Key)
+}
+
+func toEdge(e edgeRecord) Edge {
+	return Edge{
+		Key:    e.Key,
+		Class:  e.Class,
+		Label:  e.Label,
+		Source: e.Source,
+		Target: e.Target,
+	}
+}
+
+func (api *API) ListEdges(ctx context.Context, request ListEdgesRequestObject) (ListEdgesResponseObject, error) {
+	api.mu.RLock()
+	defer api.mu.RUnlock()
+
+	edges := []Edge{}
+	for _, k := range api.catalog.edgeKeys() {
+		edges = append(edges, toEdge(api.catalog.edges[k]))
+	}
+	return ListEdges200JSONResponse(edges), nil
+}
+
+func (api *API) CreateEdge(ctx context.Context, request CreateEdgeRequestObject) (CreateEdgeResponseObject, error) {
+	if request.Body == nil {
+		ir := InvalidRequestJSONResponse{Code: 422, Error: "request body is required"}
+		return CreateEdge422JSONResponse{InvalidRequestJSONResponse: ir}, nil
+	}
+
+	body := *request.Body
+	if body.Source == "" || body.Target == "" || body.Class == "" || body.Label == "" {
+		ir := InvalidRequestJSONResponse{Code: 422, Error: "source, target, class and label are required"}
+		return CreateEdge422JSONResponse{InvalidRequestJSONResponse: ir}, nil
+	}
+
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	for _, k := range []string{body.Source, body.Target} {
+		if _, ok := api.catalog.vertices[k]; !ok {
+			ir := InvalidRequestJSONResponse{Code: 422, Error: fmt.Sprintf("edge references unknown vertex %q", k)}
+			return CreateEdge422JSONResponse{InvalidRequestJSONResponse: ir}, nil
+		}
+	}
+
+	e := edgeRecord{
+		Key:    edgeKey(body.Source, body.Target),
+		Source: body.Source,
+		Target: body.Target,
+		Class:  body.Class,
+		Label:  body.Label,
+	}
+
+	if _, ok := api.catalog.edges[e.Key]; ok {
+		ir := InvalidRequestJSONResponse{Code: 422, Error: fmt.Sprintf("edge %q already exists", e.Key)}
+		return CreateEdge422JSONResponse{InvalidRequestJSONResponse: ir}, nil
+	}
+
+	err := api.addEdge(e)
+	if errors.As(err, &graphlib.CycleErr{}) || errors.As(err, &graphlib.BidirectionalEdgeErr{}) {
+		ir := InvalidRequestJSONResponse{Code: 422, Error: err.Error()}
+		return CreateEdge422JSONResponse{InvalidRequestJSONResponse: ir}, nil
+	}
+	if err != nil {
+		ise := InternalServerErrorJSONResponse{Code: 500, Error: err.Error()}
+		return CreateEdge500JSONResponse{InternalServerErrorJSONResponse: ise}, nil
+	}
+
+	api.events.publish(edgeEvent("edge.created", e))
+	api.announceHealthLocked()
+	return CreateEdge201JSONResponse(toEdge(e)), nil
+}
+
+func (api *API) GetEdge(ctx context.Context, request GetEdgeRequestObject) (GetEdgeResponseObject, error) {
+	api.mu.RLock()
+	defer api.mu.RUnlock()
+
+	e, ok := api.catalog.edges[request.Key]
+	if !ok {
+		nf := NotFoundJSONResponse{Code: 404, Error: edgeNotFoundErr{Key: request.Key}.Error()}
+		return GetEdge404JSONResponse{NotFoundJSONResponse: nf}, nil
+	}
+	return GetEdge200JSONResponse(toEdge(e)), nil
+}
+
+func (api *API) UpdateEdge(ctx context.Context, request UpdateEdgeRequestObject) (UpdateEdgeResponseObject, error) {
+	if request.Body == nil {
+		ir := InvalidRequestJSONResponse{Code: 422, Error: "request body is required"}
+		return UpdateEdge422JSONResponse{InvalidRequestJSONResponse: ir}, nil
+	}
+
+	body := *request.Body
+	if body.Class == "" || body.Label == "" {
+		ir := InvalidRequestJSONResponse{Code: 422, Error: "class and label are required"}
+		return UpdateEdge422JSONResponse{InvalidRequestJSONResponse: ir}, nil
+	}
+
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	e, ok := api.catalog.edges[request.Key]
+	if !ok {
+		nf := NotFoundJSONResponse{Code: 404, Error: edgeNotFoundErr{Key: request.Key}.Error()}
+		return UpdateEdge404JSONResponse{NotFoundJSONResponse: nf}, nil
+	}
+
+	e.Class = body.Class
+	e.Label = body.Label
+	api.catalog.edges[request.Key] = e
+	api.events.publish(edgeEvent("edge.updated", e))
+
+	return UpdateEdge200JSONResponse(toEdge(e)), nil
+}
+
+func (api *API) DeleteEdge(ctx context.Context, request DeleteEdgeRequestObject) (DeleteEdgeResponseObject, error) {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	e, ok := api.catalog.edges[request.Key]
+	if !ok {
+		nf := NotFoundJSONResponse{Code: 404, Error: edgeNotFoundErr{Key: request.Key}.Error()}
+		return DeleteEdge404JSONResponse{NotFoundJSONResponse: nf}, nil
+	}
+
+	next := api.catalog.clone()
+	delete(next.edges, request.Key)
+
+	if err := api.rebuild(next); err != nil {
+		ise := InternalServerErrorJSONResponse{Code: 500, Error: err.Error()}
+		return DeleteEdge500JSONResponse{InternalServerErrorJSONResponse: ise}, nil
+	}
+
+	api.events.publish(edgeEvent("edge.deleted", e))
+	api.announceHealthLocked()
+	return DeleteEdge200Response{}, nil
+}
@@ -0,0 +1,169 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func TestEdgeKey(t *testing.T) {
+	cases := []struct {
+		src, tgt string
+		want     string
+	}{
+		{src: "web", tgt: "db", want: "web->db"},
+		{src: "web-01", tgt: "db-prod-01", want: "web-01->db-prod-01"},
+		{src: "a>b", tgt: "c", want: `a\>b->c`},
+		{src: `a\`, tgt: "c", want: `a\\->c`},
+	}
+	for _, c := range cases {
+		if got := edgeKey(c.src, c.tgt); got != c.want {
+			t.Errorf("edgeKey(%q, %q) = %q, want %q", c.src, c.tgt, got, c.want)
+		}
+	}
+
+	// endpoints that only differ in where they split
+	seen := map[string][2]string{}
+	for _, p := range [][2]string{{"a-b", "c"}, {"a", "b-c"}, {"a-", ">b"}, {"a->", "b"}, {"a", "->b"}, {`a\`, ">b"}, {`a\>`, "b"}} {
+		k := edgeKey(p[0], p[1])
+		if prev, dup := seen[k]; dup {
+			t.Errorf("%v and %v share the key %q", prev, p, k)
+		}
+		seen[k] = p
+	}
+}
+
+func TestCreateEdge(t *testing.T) {
+	api := testAPI(t, "app>web")
+	for _, k := range []string{"db", "a-b", "c", "a", "b-c"} {
+		api.AddVertex(k, k, "server", true)
+	}
+
+	cases := []struct {
+		name string
+		body *NewEdge
+		key  string
+		err  string
+	}{
+		{name: "no body", err: "request body is required"},
+		{name: "no label", body: &NewEdge{Source: "web", Target: "db", Class: "runs-on"}, err: "source, target, class and label are required"},
+		{name: "unknown vertex", body: &NewEdge{Source: "web", Target: "cache", Class: "runs-on", Label: "usa"}, err: `edge references unknown vertex "cache"`},
+		{name: "created", body: &NewEdge{Source: "web", Target: "db", Class: "runs-on", Label: "usa"}, key: "web->db"},
+		{name: "repeated", body: &NewEdge{Source: "web", Target: "db", Class: "runs-on", Label: "usa"}, err: `edge "web->db" already exists`},
+		{name: "cycle", body: &NewEdge{Source: "db", Target: "app", Class: "runs-on", Label: "usa"}, err: "cycle"},
+		{name: "hyphen in the source", body: &NewEdge{Source: "a-b", Target: "c", Class: "runs-on", Label: "usa"}, key: "a-b->c"},
+		{name: "hyphen in the target", body: &NewEdge{Source: "a", Target: "b-c", Class: "runs-on", Label: "usa"}, key: "a->b-c"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			res, err := api.CreateEdge(context.Background(), CreateEdgeRequestObject{Body: c.body})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if c.err != "" {
+				r, ok := res.(CreateEdge422JSONResponse)
+				if !ok {
+					t.Fatalf("got %T, want 422", res)
+				}
+				if c.err == "cycle" {
+					return
+				}
+				if r.Error != c.err {
+					t.Fatalf("got error %q, want %q", r.Error, c.err)
+				}
+				return
+			}
+			e, ok := res.(CreateEdge201JSONResponse)
+			if !ok {
+				t.Fatalf("got %T", res)
+			}
+			if e.Key != c.key || e.Source != c.body.Source || e.Target != c.body.Target {
+				t.Errorf("got %+v", e)
+			}
+		})
+	}
+}
+
+func TestEdgeOperations(t *testing.T) {
+	api := testAPI(t, "web>db", "app>web")
+	ctx := context.Background()
+
+	res, err := api.UpdateEdge(ctx, UpdateEdgeRequestObject{Key: "web->db", Body: &EdgeUpdate{Class: "reads", Label: "replica"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e, ok := res.(UpdateEdge200JSONResponse); !ok || e.Class != "reads" || e.Label != "replica" {
+		t.Fatalf("got %+v", res)
+	}
+
+	got, _ := api.GetEdge(ctx, GetEdgeRequestObject{Key: "web->db"})
+	if e, ok := got.(GetEdge200JSONResponse); !ok || e.Class != "reads" {
+		t.Errorf("got %+v after the update", got)
+	}
+
+	// the subgraph responses use the same keys
+	deps, _ := api.GetVertexDependencies(ctx, GetVertexDependenciesRequestObject{Key: "web"})
+	sub, ok := deps.(GetVertexDependencies200JSONResponse)
+	if !ok || len(sub.Edges) != 1 || sub.Edges[0].Key != "web->db" || sub.Edges[0].Class != "reads" {
+		t.Errorf("got %+v", deps)
+	}
+
+	del, _ := api.DeleteEdge(ctx, DeleteEdgeRequestObject{Key: "web->db"})
+	if _, ok := del.(DeleteEdge200Response); !ok {
+		t.Fatalf("got %T", del)
+	}
+	list, _ := api.ListEdges(ctx, ListEdgesRequestObject{})
+	if edges := list.(ListEdges200JSONResponse); len(edges) != 1 || edges[0].Key != "app->web" {
+		t.Errorf("got %+v after the delete", edges)
+	}
+	sg, err := api.graph.VertexDependencies("web", true)
+	if err != nil || len(sg.Edges) != 0 {
+		t.Errorf("graph still has %+v", sg.Edges)
+	}
+
+	for name, res := range map[string]any{
+		"get":    must(api.GetEdge(ctx, GetEdgeRequestObject{Key: "web->db"})),
+		"update": must(api.UpdateEdge(ctx, UpdateEdgeRequestObject{Key: "web->db", Body: &EdgeUpdate{Class: "reads", Label: "replica"}})),
+		"delete": must(api.DeleteEdge(ctx, DeleteEdgeRequestObject{Key: "web->db"})),
+	} {
+		switch res.(type) {
+		case GetEdge404JSONResponse, UpdateEdge404JSONResponse, DeleteEdge404JSONResponse:
+		default:
+			t.Errorf("%s: got %T for a removed edge", name, res)
+		}
+	}
+}
+
+func must[T any](v T, err error) T {
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func TestEdgeKeyInPath(t *testing.T) {
+	api := testAPI(t)
+	api.AddVertex("a>b", "a>b", "server", true)
+	api.AddVertex("c", "c", "server", true)
+	if err := api.AddEdge("a>b", "c", "runs-on", "usa"); err != nil {
+		t.Fatal(err)
+	}
+	h := HandlerFromMux(NewStrictHandler(api, nil), http.NewServeMux())
+
+	k := edgeKey("a>b", "c")
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/edges/"+url.PathEscape(k), nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("got %d: %s", rec.Code, rec.Body)
+	}
+	e := Edge{}
+	if err := json.Unmarshal(rec.Body.Bytes(), &e); err != nil {
+		t.Fatal(err)
+	}
+	if e.Source != "a>b" || e.Target != "c" {
+		t.Errorf("got %+v", e)
+	}
+}
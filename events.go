@@ -0,0 +1,304 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/opsminded/graphlib/v2"
+)
+
+const (
+	// eventBacklog is how many past events are kept for Last-Event-ID.
+	eventBacklog = 1024
+	// subscriberBuffer is how many events a slow client may fall behind
+	// before its stream is closed. It can reconnect with Last-Event-ID.
+	subscriberBuffer  = 64
+	heartbeatInterval = 15 * time.Second
+)
+
+type eventBroker struct {
+	mu      sync.Mutex
+	lastID  int64
+	backlog []Event
+	subs    map[chan Event]struct{}
+	// forward, when set, also receives every event. It must not block.
+	forward func(Event)
+}
+
+func newEventBroker() *eventBroker {
+	return &eventBroker{
+		subs: make(map[chan Event]struct{}),
+	}
+}
+
+// publish numbers the event and hands it to every subscriber. It never
+// blocks, so it is safe to call while holding api.mu.
+func (b *eventBroker) publish(e Event) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.lastID++
+	e.Id = b.lastID
+	e.Time = time.Now().UTC()
+
+	b.backlog = append(b.backlog, e)
+	if len(b.backlog) > eventBacklog {
+		b.backlog = b.backlog[len(b.backlog)-eventBacklog:]
+	}
+
+	if b.forward != nil {
+		b.forward(e)
+	}
+	for ch := range b.subs {
+		select {
+		case ch <- e:
+		default:
+			delete(b.subs, ch)
+			close(ch)
+		}
+	}
+}
+
+// subscribe returns the retained events after lastID, none when lastID is
+// negative, and a channel with the ones that follow. The channel is closed
+// when the subscriber falls behind.
+func (b *eventBroker) subscribe(lastID int64) ([]Event, chan Event, func()) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	missed := []Event{}
+	for _, e := range b.backlog {
+		if lastID >= 0 && e.Id > lastID {
+			missed = append(missed, e)
+		}
+	}
+
+	ch := make(chan Event, subscriberBuffer)
+	b.subs[ch] = struct{}{}
+
+	cancel := func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		if _, ok := b.subs[ch]; ok {
+			delete(b.subs, ch)
+			close(ch)
+		}
+	}
+	return missed, ch, cancel
+}
+
+func vertexEvent(typ string, key, class string, healthy bool) Event {
+	return Event{Type: typ, Key: &key, Class: &class, Healthy: &healthy}
+}
+
+func edgeEvent(typ string, e edgeRecord) Event {
+	return Event{Type: typ, Key: &e.Key, Class: &e.Class, Source: &e.Source, Target: &e.Target}
+}
+
+func healthEvent(key, class string, healthy bool) Event {
+	typ := "vertex.healthy"
+	if !healthy {
+		typ = "vertex.unhealthy"
+	}
+	return vertexEvent(typ, key, class, healthy)
+}
+
+type eventFilter struct {
+	keys         map[string]struct{}
+	classes      map[string]struct{}
+	dependentsOf string
+}
+
+func (api *API) matchesEvent(f eventFilter, e Event) bool {
+	keys := []string{}
+	for _, k := range []*string{e.Key, e.Source, e.Target} {
+		if k != nil {
+			keys = append(keys, *k)
+		}
+	}
+	anyKey := func(ok func(string) bool) bool {
+		for _, k := range keys {
+			if ok(k) {
+				return true
+			}
+		}
+		return false
+	}
+
+	if len(f.keys) > 0 && !anyKey(func(k string) bool { _, ok := f.keys[k]; return ok }) {
+		return false
+	}
+	if len(f.classes) > 0 {
+		if e.Class == nil {
+			return false
+		}
+		if _, ok := f.classes[*e.Class]; !ok {
+			return false
+		}
+	}
+	if f.dependentsOf != "" {
+		related := api.dependentsOf(f.dependentsOf)
+		if !anyKey(func(k string) bool { _, ok := related[k]; return ok }) {
+			return false
+		}
+	}
+	return true
+}
+
+// dependentsCache keeps the dependents of the vertices named in
+// dependents_of filters, so the subscribers share one lookup until the graph
+// changes.
+type dependentsCache struct {
+	mu      sync.Mutex
+	version uint64
+	sets    map[string]map[string]struct{}
+}
+
+// dependentsOf returns the vertices that depend on key, directly or not. The
+// vertex itself is not a dependent. The set is shared and must not be
+// changed.
+func (api *API) dependentsOf(key string) map[string]struct{} {
+	api.mu.RLock()
+	defer api.mu.RUnlock()
+
+	c := api.related
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.sets == nil || c.version != api.version {
+		c.version = api.version
+		c.sets = map[string]map[string]struct{}{}
+	}
+	if set, ok := c.sets[key]; ok {
+		return set
+	}
+
+	set := map[string]struct{}{}
+	if sub, err := api.service.VertexDependents(key, true); err == nil {
+		for _, v := range sub.SubGraph.Vertices {
+			if v.Key != key {
+				set[v.Key] = struct{}{}
+			}
+		}
+	}
+	c.sets[key] = set
+	return set
+}
+
+// eventStream is the GetEvents response. It writes events as they are
+// published until the client goes away or falls behind.
+type eventStream struct {
+	api    *API
+	ctx    context.Context
+	filter eventFilter
+	lastID int64
+}
+
+func (s eventStream) VisitGetEventsResponse(w http.ResponseWriter) error {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		return errors.New("response writer does not support streaming")
+	}
+
+	missed, ch, cancel := s.api.events.subscribe(s.lastID)
+	defer cancel()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(200)
+	flusher.Flush()
+
+	write := func(e Event) error {
+		if !s.api.matchesEvent(s.filter, e) {
+			return nil
+		}
+		data, err := json.Marshal(e)
+		if err != nil {
+			return err
+		}
+		if _, err := fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", e.Id, e.Type, data); err != nil {
+			return err
+		}
+		flusher.Flush()
+		return nil
+	}
+
+	for _, e := range missed {
+		if err := write(e); err != nil {
+			return err
+		}
+	}
+
+	heartbeat := time.NewTicker(heartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-s.ctx.Done():
+			return nil
+		case e, ok := <-ch:
+			if !ok {
+				return nil
+			}
+			if err := write(e); err != nil {
+				return err
+			}
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(w, ": ping\n\n"); err != nil {
+				return err
+			}
+			flusher.Flush()
+		}
+	}
+}
+
+func (api *API) GetEvents(ctx context.Context, request GetEventsRequestObject) (GetEventsResponseObject, error) {
+	params := request.Params
+	filter := eventFilter{
+		keys:    map[string]struct{}{},
+		classes: map[string]struct{}{},
+	}
+	if params.Key != nil {
+		for _, k := range *params.Key {
+			filter.keys[k] = struct{}{}
+		}
+	}
+	if params.Class != nil {
+		for _, c := range *params.Class {
+			filter.classes[c] = struct{}{}
+		}
+	}
+
+	if params.DependentsOf != nil && *params.DependentsOf != "" {
+		_, err := api.svc().GetVertex(*params.DependentsOf)
+		if errors.As(err, &graphlib.VertexNotFoundErr{}) {
+			nf := NotFoundJSONResponse{Code: 404, Error: err.Error()}
+			return GetEvents404JSONResponse{NotFoundJSONResponse: nf}, nil
+		}
+		if err != nil {
+			ise := InternalServerErrorJSONResponse{Code: 500, Error: err.Error()}
+			return GetEvents500JSONResponse{InternalServerErrorJSONResponse: ise}, nil
+		}
+		filter.dependentsOf = *params.DependentsOf
+	}
+
+	// a client without Last-Event-ID only wants what happens from now on
+	lastID := int64(-1)
+	if params.LastEventID != nil && *params.LastEventID != "" {
+		id, err := strconv.ParseInt(*params.LastEventID, 10, 64)
+		if err != nil {
+			ir := InvalidRequestJSONResponse{Code: 422, Error: fmt.Sprintf("invalid Last-Event-ID %q", *params.LastEventID)}
+			return GetEvents422JSONResponse{InvalidRequestJSONResponse: ir}, nil
+		}
+		lastID = id
+	}
+
+	return eventStream{api: api, ctx: ctx, filter: filter, lastID: lastID}, nil
+}
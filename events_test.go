@@ -0,0 +1,297 @@
+package api
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestBrokerReplay(t *testing.T) {
+	b := newEventBroker()
+	for _, k := range []string{"web", "db", "app"} {
+		b.publish(healthEvent(k, "server", false))
+	}
+
+	missed, _, cancel := b.subscribe(1)
+	defer cancel()
+	if len(missed) != 2 || missed[0].Id != 2 || missed[1].Id != 3 || *missed[0].Key != "db" {
+		t.Errorf("got %+v after id 1", missed)
+	}
+	if missed, _, cancel := b.subscribe(-1); len(missed) != 0 {
+		t.Errorf("got %+v without Last-Event-ID", missed)
+		cancel()
+	}
+
+	_, ch, cancel := b.subscribe(-1)
+	b.publish(healthEvent("web", "server", true))
+	if e := <-ch; e.Id != 4 || e.Type != "vertex.healthy" {
+		t.Errorf("got %+v", e)
+	}
+	cancel()
+	cancel()
+	if _, ok := <-ch; ok {
+		t.Error("the channel is open after cancel")
+	}
+}
+
+func TestBrokerBacklog(t *testing.T) {
+	b := newEventBroker()
+	for range eventBacklog + 10 {
+		b.publish(healthEvent("web", "server", false))
+	}
+	missed, _, cancel := b.subscribe(0)
+	defer cancel()
+	if len(missed) != eventBacklog || missed[0].Id != 11 {
+		t.Errorf("got %d events starting at %d", len(missed), missed[0].Id)
+	}
+}
+
+func TestBrokerSlowSubscriber(t *testing.T) {
+	b := newEventBroker()
+	_, slow, cancel := b.subscribe(-1)
+	defer cancel()
+	for range subscriberBuffer + 1 {
+		b.publish(healthEvent("web", "server", false))
+	}
+
+	n := 0
+	for range slow {
+		n++
+	}
+	if n != subscriberBuffer {
+		t.Errorf("got %d events before the close, want %d", n, subscriberBuffer)
+	}
+	if len(b.subs) != 0 {
+		t.Error("the slow subscriber is still registered")
+	}
+}
+
+// collect subscribes to the API events and returns a function that takes
+// what was published since, without waiting.
+func collect(t *testing.T, api *API) func() []Event {
+	_, ch, cancel := api.events.subscribe(-1)
+	t.Cleanup(cancel)
+	return func() []Event {
+		got := []Event{}
+		for {
+			select {
+			case e := <-ch:
+				got = append(got, e)
+			default:
+				return got
+			}
+		}
+	}
+}
+
+func healthEvents(events []Event) map[string]bool {
+	got := map[string]bool{}
+	for _, e := range events {
+		if e.Healthy != nil && strings.HasPrefix(e.Type, "vertex.") && e.Type != "vertex.created" {
+			got[*e.Key] = *e.Healthy
+		}
+	}
+	return got
+}
+
+func TestAnnounceInherited(t *testing.T) {
+	api := testAPI(t, "web>db", "app>web")
+	ctx := context.Background()
+	announce := func() {
+		api.mu.Lock()
+		defer api.mu.Unlock()
+		api.announceHealthLocked()
+	}
+	announce()
+	taken := collect(t, api)
+
+	if _, err := api.MarkVertexUnhealthy(ctx, MarkVertexUnhealthyRequestObject{Key: "db"}); err != nil {
+		t.Fatal(err)
+	}
+	if got := healthEvents(taken()); len(got) != 1 || got["db"] {
+		t.Errorf("got %v after the report", got)
+	}
+
+	// what the loop does on its next tick
+	for _, k := range []string{"web", "app"} {
+		if err := api.graph.SetVertexHealth(k, false); err != nil {
+			t.Fatal(err)
+		}
+	}
+	announce()
+	if got := healthEvents(taken()); len(got) != 2 || got["web"] || got["app"] {
+		t.Errorf("got %v after the propagation", got)
+	}
+
+	// nothing changed, nothing announced
+	announce()
+	if got := taken(); len(got) != 0 {
+		t.Errorf("got %+v", got)
+	}
+
+	res, err := api.SetVertexPropagation(ctx, SetVertexPropagationRequestObject{Key: "app", Body: &PropagationPolicy{Mode: Ignore}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := res.(SetVertexPropagation200JSONResponse); !ok {
+		t.Fatalf("got %T", res)
+	}
+	if got := healthEvents(taken()); len(got) != 1 || !got["app"] {
+		t.Errorf("got %v after ignoring web", got)
+	}
+}
+
+func TestAnnounceLoopTick(t *testing.T) {
+	api := testAPI(t, "web>db")
+	taken := collect(t, api)
+
+	ctx, stop := context.WithCancel(context.Background())
+	defer stop()
+	// nothing reports, so the loop marks both vertices unhealthy
+	api.StartHealthCheckLoop(ctx, 20*time.Millisecond)
+
+	got := map[string]bool{}
+	deadline := time.Now().Add(5 * time.Second)
+	for len(got) < 2 {
+		if time.Now().After(deadline) {
+			t.Fatalf("got %v from the loop", got)
+		}
+		time.Sleep(10 * time.Millisecond)
+		for k, h := range healthEvents(taken()) {
+			got[k] = h
+		}
+	}
+	if got["web"] || got["db"] {
+		t.Errorf("got %v", got)
+	}
+}
+
+func TestAnnounceWithoutLock(t *testing.T) {
+	api := testAPI(t)
+	defer func() {
+		if recover() == nil {
+			t.Error("announceHealthLocked ran without api.mu")
+		}
+	}()
+	api.announceHealthLocked()
+}
+
+type sseEvent struct {
+	id, typ string
+	event   Event
+}
+
+func readEvents(t *testing.T, sc *bufio.Scanner, n int) []sseEvent {
+	t.Helper()
+	got := []sseEvent{}
+	cur := sseEvent{}
+	for len(got) < n && sc.Scan() {
+		line := sc.Text()
+		switch {
+		case strings.HasPrefix(line, "id: "):
+			cur.id = strings.TrimPrefix(line, "id: ")
+		case strings.HasPrefix(line, "event: "):
+			cur.typ = strings.TrimPrefix(line, "event: ")
+		case strings.HasPrefix(line, "data: "):
+			if err := json.Unmarshal([]byte(strings.TrimPrefix(line, "data: ")), &cur.event); err != nil {
+				t.Fatal(err)
+			}
+		case line == "" && cur.id != "":
+			got = append(got, cur)
+			cur = sseEvent{}
+		}
+	}
+	if len(got) < n {
+		t.Fatalf("got %d events, want %d: %v", len(got), n, sc.Err())
+	}
+	return got
+}
+
+func TestEventStream(t *testing.T) {
+	api := testAPI(t, "web>db")
+	srv := httptest.NewServer(HandlerFromMux(NewStrictHandler(api, nil), http.NewServeMux()))
+	defer srv.Close()
+
+	// ids 1 to 3: db, web and a cache created meanwhile
+	for _, k := range []string{"db", "web"} {
+		api.events.publish(healthEvent(k, "server", false))
+	}
+	api.events.publish(vertexEvent("vertex.created", "cache", "cache", true))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL+"/events?class=server", nil)
+	req.Header.Set("Last-Event-ID", "1")
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK || res.Header.Get("Content-Type") != "text/event-stream" {
+		t.Fatalf("got %d %s", res.StatusCode, res.Header.Get("Content-Type"))
+	}
+	sc := bufio.NewScanner(res.Body)
+
+	// the replay skips what the client has and what the filter leaves out
+	replayed := readEvents(t, sc, 1)
+	if e := replayed[0]; e.id != "2" || e.typ != "vertex.unhealthy" || *e.event.Key != "web" {
+		t.Errorf("got %+v", e)
+	}
+
+	if _, err := api.MarkVertexUnhealthy(context.Background(), MarkVertexUnhealthyRequestObject{Key: "db"}); err != nil {
+		t.Fatal(err)
+	}
+	live := readEvents(t, sc, 1)
+	if e := live[0]; e.typ != "vertex.unhealthy" || e.event.Id <= 3 {
+		t.Errorf("got %+v", e)
+	}
+}
+
+func TestGetEventsParams(t *testing.T) {
+	api := testAPI(t, "web>db")
+	ctx := context.Background()
+
+	gone := "gone"
+	res, _ := api.GetEvents(ctx, GetEventsRequestObject{Params: GetEventsParams{DependentsOf: &gone}})
+	if _, ok := res.(GetEvents404JSONResponse); !ok {
+		t.Errorf("got %T for an unknown dependents_of", res)
+	}
+	bad := "last"
+	res, _ = api.GetEvents(ctx, GetEventsRequestObject{Params: GetEventsParams{LastEventID: &bad}})
+	if r, ok := res.(GetEvents422JSONResponse); !ok || r.Error != `invalid Last-Event-ID "last"` {
+		t.Errorf("got %+v for a bad Last-Event-ID", res)
+	}
+}
+
+func TestDependentsOf(t *testing.T) {
+	api := testAPI(t, "web>db", "app>web")
+
+	got := api.dependentsOf("db")
+	if len(got) != 2 {
+		t.Errorf("got %v", got)
+	}
+	if _, self := got["db"]; self {
+		t.Error("db depends on itself")
+	}
+	api.related.sets["db"]["stale"] = struct{}{}
+	if _, ok := api.dependentsOf("db")["stale"]; !ok {
+		t.Error("the set was resolved again without a change to the graph")
+	}
+
+	api.AddVertex("batch", "batch", "server", true)
+	if err := api.AddEdge("batch", "db", "runs-on", "usa"); err != nil {
+		t.Fatal(err)
+	}
+	got = api.dependentsOf("db")
+	if _, ok := got["batch"]; !ok || len(got) != 3 {
+		t.Errorf("got %v after the new edge", got)
+	}
+	if len(api.dependentsOf("gone")) != 0 {
+		t.Error("an unknown vertex has dependents")
+	}
+}
@@ -0,0 +1,293 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var exportMediaTypes = map[string]string{
+	"text/vnd.graphviz":       "dot",
+	"application/graphml+xml": "graphml",
+	"text/vnd.mermaid":        "mermaid",
+	"application/json":        "cytoscape",
+}
+
+// formatFromAccept returns the first export format listed in an Accept
+// header. Quality values are not taken into account.
+func formatFromAccept(accept string) string {
+	for _, part := range strings.Split(accept, ",") {
+		mt := strings.TrimSpace(strings.SplitN(part, ";", 2)[0])
+		if f, ok := exportMediaTypes[mt]; ok {
+			return f
+		}
+	}
+	return ""
+}
+
+func sortSubgraph(sub Subgraph) Subgraph {
+	sub.Vertices = append([]Vertex{}, sub.Vertices...)
+	sub.Edges = append([]Edge{}, sub.Edges...)
+	sort.Slice(sub.Vertices, func(i, j int) bool { return sub.Vertices[i].Key < sub.Vertices[j].Key })
+	sort.Slice(sub.Edges, func(i, j int) bool { return sub.Edges[i].Key < sub.Edges[j].Key })
+	return sub
+}
+
+func dotQuote(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(s) + `"`
+}
+
+func writeDOT(sub Subgraph) *bytes.Buffer {
+	var b bytes.Buffer
+
+	fmt.Fprintf(&b, "digraph %s {\n", dotQuote(sub.Title))
+	for _, v := range sub.Vertices {
+		color := "green"
+		if !v.Healthy {
+			color = "red"
+		}
+		fmt.Fprintf(&b, "  %s [label=%s, class=%s, color=%s];\n", dotQuote(v.Key), dotQuote(v.Label), dotQuote(v.Class), color)
+	}
+	for _, e := range sub.Edges {
+		fmt.Fprintf(&b, "  %s -> %s [label=%s, class=%s];\n", dotQuote(e.Source), dotQuote(e.Target), dotQuote(e.Label), dotQuote(e.Class))
+	}
+	b.WriteString("}\n")
+	return &b
+}
+
+func mermaidQuote(s string) string {
+	return `"` + strings.ReplaceAll(s, `"`, "#quot;") + `"`
+}
+
+// writeMermaid writes a flowchart. Mermaid ids are restricted, so vertices
+// are numbered and their keys kept in the labels.
+func writeMermaid(sub Subgraph) *bytes.Buffer {
+	var b bytes.Buffer
+
+	ids := make(map[string]string, len(sub.Vertices))
+	b.WriteString("flowchart LR\n")
+	for i, v := range sub.Vertices {
+		id := "v" + strconv.Itoa(i)
+		ids[v.Key] = id
+		fmt.Fprintf(&b, "  %s[%s]\n", id, mermaidQuote(v.Label+" ("+v.Key+")"))
+	}
+	for _, e := range sub.Edges {
+		src, ok1 := ids[e.Source]
+		tgt, ok2 := ids[e.Target]
+		if !ok1 || !ok2 {
+			continue
+		}
+		fmt.Fprintf(&b, "  %s -->|%s| %s\n", src, mermaidQuote(e.Label), tgt)
+	}
+
+	unhealthy := []string{}
+	for _, v := range sub.Vertices {
+		if !v.Healthy {
+			unhealthy = append(unhealthy, ids[v.Key])
+		}
+	}
+	if len(unhealthy) > 0 {
+		b.WriteString("  classDef unhealthy fill:#f88,stroke:#c00\n")
+		fmt.Fprintf(&b, "  class %s unhealthy\n", strings.Join(unhealthy, ","))
+	}
+	return &b
+}
+
+func writeGraphML(sub Subgraph) (*bytes.Buffer, error) {
+	doc := graphML{
+		Xmlns: graphMLNamespace,
+		Keys: []graphMLKey{
+			{ID: "v_label", For: "node", AttrName: "label", AttrType: "string"},
+			{ID: "v_class", For: "node", AttrName: "class", AttrType: "string"},
+			{ID: "v_healthy", For: "node", AttrName: "healthy", AttrType: "boolean"},
+			{ID: "e_label", For: "edge", AttrName: "label", AttrType: "string"},
+			{ID: "e_class", For: "edge", AttrName: "class", AttrType: "string"},
+		},
+		Graph: graphMLGraph{ID: "G", EdgeDefault: "directed"},
+	}
+
+	for _, v := range sub.Vertices {
+		doc.Graph.Nodes = append(doc.Graph.Nodes, graphMLNode{
+			ID: v.Key,
+			Data: []graphMLData{
+				{Key: "v_label", Value: v.Label},
+				{Key: "v_class", Value: v.Class},
+				{Key: "v_healthy", Value: strconv.FormatBool(v.Healthy)},
+			},
+		})
+	}
+	for _, e := range sub.Edges {
+		doc.Graph.Edges = append(doc.Graph.Edges, graphMLEdge{
+			ID:     e.Key,
+			Source: e.Source,
+			Target: e.Target,
+			Data: []graphMLData{
+				{Key: "e_label", Value: e.Label},
+				{Key: "e_class", Value: e.Class},
+			},
+		})
+	}
+
+	var b bytes.Buffer
+	b.WriteString(xml.Header)
+	enc := xml.NewEncoder(&b)
+	enc.Indent("", "  ")
+	if err := enc.Encode(doc); err != nil {
+		return nil, err
+	}
+	b.WriteString("\n")
+	return &b, nil
+}
+
+func toCytoscape(sub Subgraph) CytoscapeGraph {
+	g := CytoscapeGraph{
+		Elements: CytoscapeElements{
+			Nodes: []CytoscapeElement{},
+			Edges: []CytoscapeElement{},
+		},
+	}
+	for _, v := range sub.Vertices {
+		g.Elements.Nodes = append(g.Elements.Nodes, CytoscapeElement{Data: map[string]interface{}{
+			"id":      v.Key,
+			"label":   v.Label,
+			"class":   v.Class,
+			"healthy": v.Healthy,
+		}})
+	}
+	for _, e := range sub.Edges {
+		g.Elements.Edges = append(g.Elements.Edges, CytoscapeElement{Data: map[string]interface{}{
+			"id":     e.Key,
+			"source": e.Source,
+			"target": e.Target,
+			"label":  e.Label,
+			"class":  e.Class,
+		}})
+	}
+	return g
+}
+
+// exportSubgraph runs the query selected by scope through the regular
+// handlers. A non-nil response means the query failed.
+func (api *API) exportSubgraph(ctx context.Context, params ExportGraphParams) (Subgraph, ExportGraphResponseObject, error) {
+	scope := "graph"
+	if params.Scope != nil && *params.Scope != "" {
+		scope = *params.Scope
+	}
+	if scope == "graph" {
+		return api.wholeGraph(), nil, nil
+	}
+
+	key := ""
+	if params.Key != nil {
+		key = *params.Key
+	}
+	if key == "" {
+		ir := InvalidRequestJSONResponse{Code: 422, Error: fmt.Sprintf("key is required for scope %q", scope)}
+		return Subgraph{}, ExportGraph422JSONResponse{InvalidRequestJSONResponse: ir}, nil
+	}
+
+	var (
+		res interface{}
+		err error
+	)
+	switch scope {
+	case "dependencies":
+		res, err = api.GetVertexDependencies(ctx, GetVertexDependenciesRequestObject{Key: key, Params: GetVertexDependenciesParams{All: params.All}})
+	case "dependents":
+		res, err = api.GetVertexDependents(ctx, GetVertexDependentsRequestObject{Key: key, Params: GetVertexDependentsParams{All: params.All}})
+	case "neighbors":
+		res, err = api.GetVertexNeighbors(ctx, GetVertexNeighborsRequestObject{Key: key})
+	case "path":
+		if params.Target == nil || *params.Target == "" {
+			ir := InvalidRequestJSONResponse{Code: 422, Error: "target is required for scope \"path\""}
+			return Subgraph{}, ExportGraph422JSONResponse{InvalidRequestJSONResponse: ir}, nil
+		}
+		res, err = api.GetPath(ctx, GetPathRequestObject{Key: key, Target: *params.Target})
+	default:
+		ir := InvalidRequestJSONResponse{Code: 422, Error: fmt.Sprintf("unknown scope %q", scope)}
+		return Subgraph{}, ExportGraph422JSONResponse{InvalidRequestJSONResponse: ir}, nil
+	}
+	if err != nil {
+		return Subgraph{}, nil, err
+	}
+
+	switch r := res.(type) {
+	case GetVertexDependencies200JSONResponse:
+		return Subgraph(r), nil, nil
+	case GetVertexDependents200JSONResponse:
+		return Subgraph(r), nil, nil
+	case GetVertexNeighbors200JSONResponse:
+		return Subgraph(r), nil, nil
+	case GetPath200JSONResponse:
+		return Subgraph{
+			Title:      r.Title,
+			All:        r.All,
+			Principal:  r.Principal,
+			Vertices:   r.Vertices,
+			Edges:      r.Edges,
+			Highlights: r.Highlights,
+		}, nil, nil
+	case GetVertexDependencies404JSONResponse:
+		return Subgraph{}, ExportGraph404JSONResponse(r), nil
+	case GetVertexDependents404JSONResponse:
+		return Subgraph{}, ExportGraph404JSONResponse(r), nil
+	case GetVertexNeighbors404JSONResponse:
+		return Subgraph{}, ExportGraph404JSONResponse(r), nil
+	case GetPath404JSONResponse:
+		return Subgraph{}, ExportGraph404JSONResponse(r), nil
+	case GetPath409JSONResponse:
+		return Subgraph{}, ExportGraph409JSONResponse(r), nil
+	case GetPath422JSONResponse:
+		return Subgraph{}, ExportGraph422JSONResponse(r), nil
+	}
+	return Subgraph{}, nil, fmt.Errorf("%s query failed for %q", scope, key)
+}
+
+func (api *API) ExportGraph(ctx context.Context, request ExportGraphRequestObject) (ExportGraphResponseObject, error) {
+	format := ""
+	if request.Params.Format != nil {
+		format = *request.Params.Format
+	}
+	if format == "" && request.Params.Accept != nil {
+		format = formatFromAccept(*request.Params.Accept)
+	}
+	if format == "" {
+		format = "dot"
+	}
+	if _, ok := map[string]struct{}{"dot": {}, "graphml": {}, "mermaid": {}, "cytoscape": {}}[format]; !ok {
+		ir := InvalidRequestJSONResponse{Code: 422, Error: fmt.Sprintf("unknown export format %q", format)}
+		return ExportGraph422JSONResponse{InvalidRequestJSONResponse: ir}, nil
+	}
+
+	sub, failed, err := api.exportSubgraph(ctx, request.Params)
+	if err != nil {
+		ise := InternalServerErrorJSONResponse{Code: 500, Error: err.Error()}
+		return ExportGraph500JSONResponse{InternalServerErrorJSONResponse: ise}, nil
+	}
+	if failed != nil {
+		return failed, nil
+	}
+	sub = sortSubgraph(sub)
+
+	switch format {
+	case "graphml":
+		b, err := writeGraphML(sub)
+		if err != nil {
+			ise := InternalServerErrorJSONResponse{Code: 500, Error: err.Error()}
+			return ExportGraph500JSONResponse{InternalServerErrorJSONResponse: ise}, nil
+		}
+		return ExportGraph200ApplicationgraphmlXmlResponse{Body: b, ContentLength: int64(b.Len())}, nil
+	case "mermaid":
+		b := writeMermaid(sub)
+		return ExportGraph200TextvndMermaidResponse{Body: b, ContentLength: int64(b.Len())}, nil
+	case "cytoscape":
+		return ExportGraph200JSONResponse(toCytoscape(sub)), nil
+	default:
+		b := writeDOT(sub)
+		return ExportGraph200TextvndGraphvizResponse{Body: b, ContentLength: int64(b.Len())}, nil
+	}
+}
@@ -0,0 +1,248 @@
+package api
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/opsminded/graphlib/v2"
+	"github.com/opsminded/service"
+)
+
+type healthLoop struct {
+	ctx      context.Context
+	interval time.Duration
+	cancel   context.CancelFunc
+}
+
+// carriedCheck is the last check of a vertex from before a rebuild. graphlib
+// sets the last check of every vertex it adds to now, so the API keeps the
+// old one until graphlib checks the vertex again.
+type carriedCheck struct {
+	at int64
+	// rebuilt is the last check graphlib set when the vertex was added again
+	rebuilt int64
+}
+
+// healthWatch remembers the effective health last announced.
+type healthWatch struct {
+	mu      sync.Mutex
+	healthy map[string]bool
+}
+
+// AddVertex adds a vertex to the graph served by the API. Like graphlib, it
+// does nothing when the key already exists.
+func (api *API) AddVertex(key, label, class string, healthy bool) {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	api.addVertex(key, label, class, healthy)
+	api.announceHealthLocked()
+}
+
+// AddEdge adds a dependency from src to tgt to the graph served by the API.
+// It fails with the graphlib errors when a vertex is missing or the edge
+// would break the DAG.
+func (api *API) AddEdge(src, tgt, class, label string) error {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	if err := api.addEdge(edgeRecord{Key: edgeKey(src, tgt), Source: src, Target: tgt, Class: class, Label: label}); err != nil {
+		return err
+	}
+	api.announceHealthLocked()
+	return nil
+}
+
+// StartHealthCheckLoop starts the graphlib health check loop and a watch
+// that publishes the health changes it makes. Both are restarted on the new
+// graph whenever the API rebuilds it.
+func (api *API) StartHealthCheckLoop(ctx context.Context, interval time.Duration) {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	if api.loop != nil {
+		api.loop.cancel()
+	}
+	api.loop = &healthLoop{ctx: ctx, interval: interval}
+	api.startLoop()
+}
+
+// setHealth sets the health the report stands for, records the report in
+// the history and publishes the health changes it causes.
+func (api *API) setHealth(key string, r healthReport, source string) error {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	before, err := api.service.GetVertex(key)
+	if err != nil {
+		return err
+	}
+	// the loop may have propagated since the watch last looked, and the
+	// view needs to know where those failures come from before this one
+	// changes
+	api.announceHealthLocked()
+	if err := api.service.SetVertexHealth(key, r.healthy()); err != nil {
+		return err
+	}
+	api.history.report(key, before.Healthy, r, source)
+	api.announceHealthLocked()
+	return nil
+}
+
+// announceHealthLocked publishes an event for every vertex whose effective
+// health changed since the last call, whatever changed it: a report, the
+// health check loop, a failing dependency or a propagation policy. Vertices
+// seen for the first time are only recorded. It also remembers where the
+// propagated failures come from, for healthViewLocked. Callers must hold
+// api.mu, read or write, so the view and the graph do not change while it
+// runs.
+func (api *API) announceHealthLocked() {
+	// TryLock only succeeds when nobody holds api.mu, the caller included
+	if api.mu.TryLock() {
+		api.mu.Unlock()
+		panic("announceHealthLocked called without holding api.mu")
+	}
+
+	api.watch.mu.Lock()
+	defer api.watch.mu.Unlock()
+
+	view := api.healthViewLocked()
+	for _, k := range api.catalog.vertexKeys() {
+		before, known := api.watch.healthy[k]
+		if known && before != view.healthy[k] {
+			api.events.publish(healthEvent(k, api.catalog.vertices[k].Class, view.healthy[k]))
+		}
+	}
+	api.watch.healthy = view.healthy
+	api.history.setPropagation(view.propagated)
+}
+
+// watchHealth announces the changes the graphlib loop makes. The loop only
+// changes the health on its ticks, so the watch looks half an interval after
+// each of them.
+func (api *API) watchHealth(ctx context.Context, interval time.Duration) {
+	select {
+	case <-time.After(interval / 2):
+	case <-ctx.Done():
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		api.mu.Lock()
+		api.expireChecks(interval, time.Now().UnixNano())
+		api.announceHealthLocked()
+		api.mu.Unlock()
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// expireChecks does for the carried checks what the health check loop does
+// for the others: a healthy vertex not checked within the interval becomes
+// unhealthy. Callers must hold api.mu.
+func (api *API) expireChecks(interval time.Duration, now int64) {
+	for k, c := range api.carried {
+		v, err := api.graph.GetVertex(k)
+		if err != nil || v.LastCheck != c.rebuilt {
+			delete(api.carried, k)
+			continue
+		}
+		if v.Healthy && c.at+interval.Nanoseconds() < now {
+			_ = api.graph.SetVertexHealth(k, false)
+			delete(api.carried, k)
+		}
+	}
+}
+
+// lastCheckLocked returns the last check of v, carried over the rebuilds
+// that did not check it. Callers must hold api.mu.
+func (api *API) lastCheckLocked(v graphlib.Vertex) int64 {
+	if c, ok := api.carried[v.Key]; ok && c.rebuilt == v.LastCheck {
+		return c.at
+	}
+	return v.LastCheck
+}
+
+func (api *API) svc() *service.Service {
+	api.mu.RLock()
+	defer api.mu.RUnlock()
+
+	return api.service
+}
+
+func (api *API) addVertex(key, label, class string, healthy bool) {
+	if _, ok := api.catalog.vertices[key]; ok {
+		return
+	}
+	api.catalog.vertices[key] = vertexRecord{Key: key, Label: label, Class: class}
+	api.graph.AddVertex(key, label, class, healthy)
+	api.version++
+}
+
+func (api *API) addEdge(e edgeRecord) error {
+	if err := api.graph.AddEdge(e.Source, e.Target); err != nil {
+		return err
+	}
+	api.catalog.edges[e.Key] = e
+	api.version++
+	return nil
+}
+
+// rebuild makes next the catalog and replaces the graph with one built from
+// it. Nothing changes when the graph cannot be built. The vertices that
+// survive keep their health and their last check. Callers must hold api.mu.
+func (api *API) rebuild(next *catalog) error {
+	g := graphlib.NewSoAGraph(api.logger)
+
+	for _, k := range next.vertexKeys() {
+		v := next.vertices[k]
+		healthy := true
+		if old, err := api.graph.GetVertex(k); err == nil {
+			healthy = old.Healthy
+		}
+		g.AddVertex(v.Key, v.Label, v.Class, healthy)
+	}
+
+	for _, k := range next.edgeKeys() {
+		e := next.edges[k]
+		if err := g.AddEdge(e.Source, e.Target); err != nil {
+			return err
+		}
+	}
+
+	carried := make(map[string]carriedCheck, len(next.vertices))
+	for _, k := range next.vertexKeys() {
+		old, err := api.graph.GetVertex(k)
+		if err != nil {
+			continue
+		}
+		v, _ := g.GetVertex(k)
+		carried[k] = carriedCheck{at: api.lastCheckLocked(old), rebuilt: v.LastCheck}
+	}
+
+	api.catalog = next
+	api.graph = g
+	api.service = service.New(g)
+	api.carried = carried
+	api.version++
+
+	if api.loop != nil {
+		api.loop.cancel()
+		api.startLoop()
+	}
+	return nil
+}
+
+func (api *API) startLoop() {
+	ctx, cancel := context.WithCancel(api.loop.ctx)
+	api.loop.cancel = cancel
+	api.graph.StartHealthCheckLoop(ctx, api.loop.interval)
+	go api.watchHealth(ctx, api.loop.interval)
+}
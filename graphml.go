@@ -0,0 +1,68 @@
+package api
+
+import "encoding/xml"
+
+const graphMLNamespace = "http://graphml.graphdrawing.org/xmlns"
+
+type graphML struct {
+	XMLName xml.Name     `xml:"graphml"`
+	Xmlns   string       `xml:"xmlns,attr,omitempty"`
+	Keys    []graphMLKey `xml:"key"`
+	Graph   graphMLGraph `xml:"graph"`
+}
+
+type graphMLKey struct {
+	ID       string `xml:"id,attr"`
+	For      string `xml:"for,attr"`
+	AttrName string `xml:"attr.name,attr"`
+	AttrType string `xml:"attr.type,attr"`
+}
+
+type graphMLGraph struct {
+	ID          string        `xml:"id,attr,omitempty"`
+	EdgeDefault string        `xml:"edgedefault,attr"`
+	Nodes       []graphMLNode `xml:"node"`
+	Edges       []graphMLEdge `xml:"edge"`
+}
+
+type graphMLNode struct {
+	ID   string        `xml:"id,attr"`
+	Data []graphMLData `xml:"data"`
+}
+
+type graphMLEdge struct {
+	ID     string        `xml:"id,attr,omitempty"`
+	Source string        `xml:"source,attr"`
+	Target string        `xml:"target,attr"`
+	Data   []graphMLData `xml:"data"`
+}
+
+type graphMLData struct {
+	Key   string `xml:"key,attr"`
+	Value string `xml:",chardata"`
+}
+
+// attrs resolves the data elements of a node or edge to attribute names
+// using the key declarations of the document.
+func (g graphML) attrs(domain string, data []graphMLData) map[string]string {
+	names := make(map[string]string, len(g.Keys))
+	for _, k := range g.Keys {
+		if k.For == domain || k.For == "all" {
+			name := k.AttrName
+			if name == "" {
+				name = k.ID
+			}
+			names[k.ID] = name
+		}
+	}
+
+	out := make(map[string]string, len(data))
+	for _, d := range data {
+		name, ok := names[d.Key]
+		if !ok {
+			name = d.Key
+		}
+		out[name] = d.Value
+	}
+	return out
+}
@@ -0,0 +1,71 @@
+package api
+
+import (
+	"fmt"
+	"strings"
+)
+
+var (
+	unhealthyHighlight = []string{"unhealthy"}
+	pathHighlight      = []string{"endpoints", "unhealthy"}
+)
+
+// highlight fills sub.Highlights with the vertices that match any of the
+// rules, or the operation defaults when the caller gave none. endpoints are
+// the keys matched by the "endpoints" rule.
+func (api *API) highlight(sub *Subgraph, rules *Highlight, defaults []string, endpoints ...string) error {
+	selected := defaults
+	if rules != nil && len(*rules) > 0 {
+		selected = *rules
+	}
+
+	ends := make(map[string]struct{}, len(endpoints))
+	for _, k := range endpoints {
+		ends[k] = struct{}{}
+	}
+
+	matchers := []func(Vertex) bool{}
+	for _, r := range selected {
+		name, arg, _ := strings.Cut(r, ":")
+		switch name {
+		case "none":
+		case "unhealthy":
+			matchers = append(matchers, func(v Vertex) bool { return !v.Healthy })
+		case "endpoints":
+			matchers = append(matchers, func(v Vertex) bool { _, ok := ends[v.Key]; return ok })
+		case "class":
+			if arg == "" {
+				return fmt.Errorf("highlight rule %q needs a class", r)
+			}
+			matchers = append(matchers, func(v Vertex) bool { return v.Class == arg })
+		case "attribute":
+			filters, err := parseAttributeFilters([]string{arg})
+			if err != nil {
+				return fmt.Errorf("highlight rule %q: %w", r, err)
+			}
+			matchers = append(matchers, func(v Vertex) bool { return api.matchesAttributes(v.Key, filters) })
+		default:
+			return fmt.Errorf("unknown highlight rule %q", r)
+		}
+	}
+
+	sub.Highlights = []Vertex{}
+	seen := map[string]struct{}{}
+	candidates := append([]Vertex{}, sub.Vertices...)
+	if sub.Principal.Key != "" {
+		candidates = append([]Vertex{sub.Principal}, candidates...)
+	}
+	for _, v := range candidates {
+		if _, dup := seen[v.Key]; dup {
+			continue
+		}
+		seen[v.Key] = struct{}{}
+		for _, m := range matchers {
+			if m(v) {
+				sub.Highlights = append(sub.Highlights, v)
+				break
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,109 @@
+package api
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+// highlighted returns the sorted keys of the vertices.
+func highlighted(vertices []Vertex) []string {
+	keys := []string{}
+	for _, v := range vertices {
+		keys = append(keys, v.Key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func TestHighlightRules(t *testing.T) {
+	api := testAPI(t, "app>web", "web>db", "app>cache")
+	api.AddVertex("queue", "queue", "queue", true)
+	owner := VertexAttribute{Description: "owner", Type: "string"}
+	owner.Value.FromVertexAttrubutesValue0("dba")
+	api.attributes.replace("db", VertexAttrubutes{owner})
+
+	sub := func(principal string, healthy map[string]bool) Subgraph {
+		s := Subgraph{Principal: Vertex{Key: principal, Class: "server", Healthy: healthy[principal]}}
+		for _, k := range []string{"cache", "db", "queue", "web"} {
+			if k == principal {
+				continue
+			}
+			class := "server"
+			if k == "queue" {
+				class = "queue"
+			}
+			s.Vertices = append(s.Vertices, Vertex{Key: k, Class: class, Healthy: healthy[k]})
+		}
+		return s
+	}
+	health := map[string]bool{"app": false, "web": false, "db": false, "cache": true, "queue": true}
+
+	cases := []struct {
+		name      string
+		rules     *Highlight
+		defaults  []string
+		endpoints []string
+		want      []string
+		err       string
+	}{
+		{name: "unhealthy includes the principal", defaults: unhealthyHighlight, endpoints: []string{"app"}, want: []string{"app", "db", "web"}},
+		{name: "path defaults", defaults: pathHighlight, endpoints: []string{"app", "cache"}, want: []string{"app", "cache", "db", "web"}},
+		{name: "rules replace the defaults", rules: &Highlight{"class:queue"}, defaults: unhealthyHighlight, want: []string{"queue"}},
+		{name: "any rule matches", rules: &Highlight{"class:queue", "attribute:owner=dba"}, want: []string{"db", "queue"}},
+		{name: "endpoints", rules: &Highlight{"endpoints"}, endpoints: []string{"app", "db"}, want: []string{"app", "db"}},
+		{name: "none", rules: &Highlight{"none"}, defaults: unhealthyHighlight, want: []string{}},
+		{name: "no rules", rules: &Highlight{}, defaults: []string{"none"}, want: []string{}},
+		{name: "class without a name", rules: &Highlight{"class"}, err: `highlight rule "class" needs a class`},
+		{name: "attribute without a value", rules: &Highlight{"attribute:owner"}, err: `highlight rule "attribute:owner": attribute filter "owner" must be description=value`},
+		{name: "unknown rule", rules: &Highlight{"red"}, err: `unknown highlight rule "red"`},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			s := sub("app", health)
+			err := api.highlight(&s, c.rules, c.defaults, c.endpoints...)
+			if c.err != "" {
+				if err == nil || err.Error() != c.err {
+					t.Fatalf("got error %v, want %q", err, c.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := highlighted(s.Highlights); !reflect.DeepEqual(got, c.want) {
+				t.Errorf("got %v, want %v", got, c.want)
+			}
+		})
+	}
+}
+
+func TestHighlightHandlers(t *testing.T) {
+	api := testAPI(t, "app>web", "web>db", "app>cache")
+	ctx := context.Background()
+	if _, err := api.MarkVertexUnhealthy(ctx, MarkVertexUnhealthyRequestObject{Key: "web"}); err != nil {
+		t.Fatal(err)
+	}
+
+	res, _ := api.GetVertexDependents(ctx, GetVertexDependentsRequestObject{Key: "web"})
+	if sub, ok := res.(GetVertexDependents200JSONResponse); !ok || !reflect.DeepEqual(highlighted(sub.Highlights), []string{"web"}) {
+		t.Errorf("dependents: got %+v", res)
+	}
+
+	res2, _ := api.GetVertexDependencies(ctx, GetVertexDependenciesRequestObject{Key: "app"})
+	if sub, ok := res2.(GetVertexDependencies200JSONResponse); !ok || !reflect.DeepEqual(highlighted(sub.Highlights), []string{"web"}) {
+		t.Errorf("dependencies: got %+v", res2)
+	}
+
+	res3, _ := api.GetPath(ctx, GetPathRequestObject{Key: "app", Target: "db"})
+	if sub, ok := res3.(GetPath200JSONResponse); !ok || !reflect.DeepEqual(highlighted(sub.Highlights), []string{"app", "db", "web"}) {
+		t.Errorf("path: got %+v", res3)
+	}
+
+	bad := Highlight{"red"}
+	res4, _ := api.GetVertexNeighbors(ctx, GetVertexNeighborsRequestObject{Key: "web", Params: GetVertexNeighborsParams{Highlight: &bad}})
+	if _, ok := res4.(GetVertexNeighbors422JSONResponse); !ok {
+		t.Errorf("neighbors: got %T for an unknown rule", res4)
+	}
+}
@@ -0,0 +1,271 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/opsminded/graphlib/v2"
+)
+
+// historyLimit is how many transitions are kept per vertex.
+const historyLimit = 1000
+
+// healthReport is the state a caller reported for a vertex.
+type healthReport struct {
+	status   HealthStatus
+	severity *Severity
+	reason   string
+}
+
+// healthy is the graph health the status stands for.
+func (r healthReport) healthy() bool {
+	return r.status == Healthy || r.status == Degraded
+}
+
+type healthHistory struct {
+	mu      sync.RWMutex
+	changes map[string][]HealthChange
+	checked map[string]time.Time
+	// reports keeps the last report of the vertices not reported healthy
+	reports map[string]healthReport
+	// propagated keeps, for the failures the loop propagated, where they
+	// came from when last announced, or "" when the policies kept the
+	// vertex healthy
+	propagated map[string]string
+}
+
+func newHealthHistory() *healthHistory {
+	return &healthHistory{
+		changes:    make(map[string][]HealthChange),
+		checked:    make(map[string]time.Time),
+		reports:    make(map[string]healthReport),
+		propagated: make(map[string]string),
+	}
+}
+
+// report records a health report for key, whose graph health was previous.
+// Only reports that change the health or the status are kept in the
+// timeline, but every report counts as a check.
+func (h *healthHistory) report(key string, previous bool, r healthReport, source string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	now := time.Now().UTC()
+	h.checked[key] = now
+	before := h.currentLocked(key, previous)
+	if r.status == Healthy {
+		delete(h.reports, key)
+	} else {
+		h.reports[key] = r
+	}
+	if previous == r.healthy() && before.status == r.status {
+		return
+	}
+
+	c := HealthChange{
+		Time:            now,
+		Healthy:         r.healthy(),
+		PreviousHealthy: previous,
+		Status:          r.status,
+		PreviousStatus:  before.status,
+		Severity:        r.severity,
+		Source:          source,
+	}
+	if r.reason != "" {
+		c.Reason = &r.reason
+	}
+	changes := append(h.changes[key], c)
+	if len(changes) > historyLimit {
+		changes = changes[len(changes)-historyLimit:]
+	}
+	h.changes[key] = changes
+}
+
+// current returns the report that explains the graph health of key. A
+// stored report the graph no longer agrees with, because the health check
+// loop or a failing dependency changed the health, is ignored.
+func (h *healthHistory) current(key string, healthy bool) healthReport {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	return h.currentLocked(key, healthy)
+}
+
+func (h *healthHistory) currentLocked(key string, healthy bool) healthReport {
+	if r, ok := h.reports[key]; ok && r.healthy() == healthy {
+		return r
+	}
+	if healthy {
+		return healthReport{status: Healthy}
+	}
+	return healthReport{status: Down}
+}
+
+// failing returns the keys last reported unhealthy.
+func (h *healthHistory) failing() map[string]struct{} {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	keys := map[string]struct{}{}
+	for k, r := range h.reports {
+		if !r.healthy() {
+			keys[k] = struct{}{}
+		}
+	}
+	return keys
+}
+
+// degraded returns the keys last reported degraded.
+func (h *healthHistory) degraded() []string {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	keys := []string{}
+	for k, r := range h.reports {
+		if r.status == Degraded {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// checkedSince reports whether key was reported at t or later.
+func (h *healthHistory) checkedSince(key string, t time.Time) bool {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	c, ok := h.checked[key]
+	return ok && !c.Before(t)
+}
+
+// propagation returns a copy of the propagated failures last announced.
+func (h *healthHistory) propagation() map[string]string {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	propagated := make(map[string]string, len(h.propagated))
+	for k, from := range h.propagated {
+		propagated[k] = from
+	}
+	return propagated
+}
+
+func (h *healthHistory) setPropagation(propagated map[string]string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.propagated = propagated
+}
+
+// lastCheck returns the time of the last report for key in RFC 3339, or an
+// empty string when there was none.
+func (h *healthHistory) lastCheck(key string) string {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	t, ok := h.checked[key]
+	if !ok {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
+// between returns the transitions of key inside the optional time range.
+func (h *healthHistory) between(key string, from, to *time.Time) HealthHistory {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	changes := HealthHistory{}
+	for _, c := range h.changes[key] {
+		if from != nil && c.Time.Before(*from) {
+			continue
+		}
+		if to != nil && c.Time.After(*to) {
+			continue
+		}
+		changes = append(changes, c)
+	}
+	return changes
+}
+
+func (h *healthHistory) remove(key string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	delete(h.changes, key)
+	delete(h.checked, key)
+	delete(h.reports, key)
+	delete(h.propagated, key)
+}
+
+// callerName is the source recorded for a health report.
+func callerName(ctx context.Context) string {
+	if id, ok := IdentityFromContext(ctx); ok && id.Subject != "" {
+		return id.Subject
+	}
+	return "anonymous"
+}
+
+func reason(r *string) string {
+	if r == nil {
+		return ""
+	}
+	return *r
+}
+
+// unhealthyReport reads the optional body of MarkVertexUnhealthy. A missing
+// status means down.
+func unhealthyReport(body *HealthReport, queryReason string) (healthReport, error) {
+	r := healthReport{status: Down, reason: queryReason}
+	if body == nil {
+		return r, nil
+	}
+
+	if body.Status != nil {
+		r.status = *body.Status
+	}
+	switch r.status {
+	case Degraded, Down, Unknown:
+	case Healthy:
+		return r, errors.New("status healthy is not accepted, mark the vertex healthy instead")
+	default:
+		return r, fmt.Errorf("unknown status %q", r.status)
+	}
+	if body.Severity != nil {
+		switch *body.Severity {
+		case Critical, Major, Minor, Warning:
+		default:
+			return r, fmt.Errorf("unknown severity %q", *body.Severity)
+		}
+		r.severity = body.Severity
+	}
+	if body.Reason != nil && *body.Reason != "" {
+		r.reason = *body.Reason
+	}
+	return r, nil
+}
+
+func (api *API) GetVertexHistory(ctx context.Context, request GetVertexHistoryRequestObject) (GetVertexHistoryResponseObject, error) {
+	_, err := api.svc().GetVertex(request.Key)
+	if errors.As(err, &graphlib.VertexNotFoundErr{}) {
+		nf := NotFoundJSONResponse{Code: 404, Error: err.Error()}
+		return GetVertexHistory404JSONResponse{NotFoundJSONResponse: nf}, nil
+	}
+	if err != nil {
+		ise := InternalServerErrorJSONResponse{Code: 500, Error: err.Error()}
+		return GetVertexHistory500JSONResponse{InternalServerErrorJSONResponse: ise}, nil
+	}
+
+	from, to := request.Params.From, request.Params.To
+	if from != nil && to != nil && to.Before(*from) {
+		ir := InvalidRequestJSONResponse{Code: 422, Error: "to must not be before from"}
+		return GetVertexHistory422JSONResponse{InvalidRequestJSONResponse: ir}, nil
+	}
+
+	return GetVertexHistory200JSONResponse(api.history.between(request.Key, from, to)), nil
+}
@@ -0,0 +1,212 @@
+package api
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestHistoryLimit(t *testing.T) {
+	h := newHealthHistory()
+	n := historyLimit + 5
+	for i := range n {
+		// every report flips the health, so each one is a transition
+		r := healthReport{status: Down}
+		if i%2 == 1 {
+			r = healthReport{status: Healthy}
+		}
+		h.report("db", i%2 == 0, r, strconv.Itoa(i))
+	}
+
+	changes := h.between("db", nil, nil)
+	if len(changes) != historyLimit {
+		t.Fatalf("got %d transitions, want %d", len(changes), historyLimit)
+	}
+	if first, last := changes[0].Source, changes[len(changes)-1].Source; first != "5" || last != strconv.Itoa(n-1) {
+		t.Errorf("kept transitions %s to %s", first, last)
+	}
+}
+
+func TestHistoryRepeatedReport(t *testing.T) {
+	h := newHealthHistory()
+	h.report("db", true, healthReport{status: Down}, "noc")
+	checked := h.checked["db"]
+	time.Sleep(time.Millisecond)
+	h.report("db", false, healthReport{status: Down}, "noc")
+
+	if got := h.between("db", nil, nil); len(got) != 1 {
+		t.Errorf("got %d transitions for the same report", len(got))
+	}
+	if !h.checked["db"].After(checked) {
+		t.Error("the repeated report did not count as a check")
+	}
+
+	// same health, another status
+	h.report("db", false, healthReport{status: Unknown}, "noc")
+	if got := h.between("db", nil, nil); len(got) != 2 || got[1].PreviousStatus != Down {
+		t.Errorf("got %+v", got)
+	}
+}
+
+func TestHistoryAfterReplaceImport(t *testing.T) {
+	api := testAPI(t, "web>db", "app>web")
+	ctx := context.Background()
+	for _, k := range []string{"app", "web"} {
+		if _, err := api.MarkVertexUnhealthy(ctx, MarkVertexUnhealthyRequestObject{Key: k}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	importJSON(t, api, Replace, Subgraph{
+		Vertices: []Vertex{testVertex("web", "server"), testVertex("db", "server")},
+		Edges:    []Edge{testEdge("web", "db")},
+	})
+	res, _ := api.GetVertexHistory(ctx, GetVertexHistoryRequestObject{Key: "app"})
+	if _, ok := res.(GetVertexHistory404JSONResponse); !ok {
+		t.Errorf("got %T for a removed vertex", res)
+	}
+
+	// a vertex imported again starts over
+	importJSON(t, api, Upsert, Subgraph{Vertices: []Vertex{testVertex("app", "server")}})
+	res, _ = api.GetVertexHistory(ctx, GetVertexHistoryRequestObject{Key: "app"})
+	if h, ok := res.(GetVertexHistory200JSONResponse); !ok || len(h) != 0 {
+		t.Errorf("got %+v for the imported vertex", res)
+	}
+	if api.history.lastCheck("app") != "" {
+		t.Error("the imported vertex kept its last check")
+	}
+	if _, ok := api.history.failing()["app"]; ok {
+		t.Error("the imported vertex kept its report")
+	}
+
+	// the vertices that stay keep theirs
+	res, _ = api.GetVertexHistory(ctx, GetVertexHistoryRequestObject{Key: "web"})
+	if h, ok := res.(GetVertexHistory200JSONResponse); !ok || len(h) != 1 {
+		t.Errorf("got %+v for web", res)
+	}
+}
+
+func TestGetVertexHistoryRange(t *testing.T) {
+	api := testAPI(t, "web>db")
+	ctx := context.Background()
+	if _, err := api.MarkVertexUnhealthy(ctx, MarkVertexUnhealthyRequestObject{Key: "db"}); err != nil {
+		t.Fatal(err)
+	}
+	mid := time.Now()
+	time.Sleep(time.Millisecond)
+	if _, err := api.MarkVertexHealthy(ctx, MarkVertexHealthyRequestObject{Key: "db"}); err != nil {
+		t.Fatal(err)
+	}
+
+	res, _ := api.GetVertexHistory(ctx, GetVertexHistoryRequestObject{Key: "db", Params: GetVertexHistoryParams{From: &mid}})
+	if h, ok := res.(GetVertexHistory200JSONResponse); !ok || len(h) != 1 || !h[0].Healthy {
+		t.Errorf("got %+v from the middle", res)
+	}
+	res, _ = api.GetVertexHistory(ctx, GetVertexHistoryRequestObject{Key: "db", Params: GetVertexHistoryParams{To: &mid}})
+	if h, ok := res.(GetVertexHistory200JSONResponse); !ok || len(h) != 1 || h[0].Healthy {
+		t.Errorf("got %+v up to the middle", res)
+	}
+	before := mid.Add(-time.Hour)
+	res, _ = api.GetVertexHistory(ctx, GetVertexHistoryRequestObject{Key: "db", Params: GetVertexHistoryParams{From: &mid, To: &before}})
+	if _, ok := res.(GetVertexHistory422JSONResponse); !ok {
+		t.Errorf("got %T for an inverted range", res)
+	}
+}
+
+func TestHealthReportHealthy(t *testing.T) {
+	for status, want := range map[HealthStatus]bool{Healthy: true, Degraded: true, Down: false, Unknown: false} {
+		if got := (healthReport{status: status}).healthy(); got != want {
+			t.Errorf("%s: got healthy %v", status, got)
+		}
+	}
+}
+
+func TestUnhealthyReport(t *testing.T) {
+	status := func(s HealthStatus) *HealthStatus { return &s }
+	severity := func(s Severity) *Severity { return &s }
+	text := func(s string) *string { return &s }
+
+	cases := []struct {
+		name   string
+		body   *HealthReport
+		query  string
+		want   healthReport
+		errMsg string
+	}{
+		{name: "no body", query: "timeout", want: healthReport{status: Down, reason: "timeout"}},
+		{name: "empty body", body: &HealthReport{}, want: healthReport{status: Down}},
+		{name: "degraded", body: &HealthReport{Status: status(Degraded)}, want: healthReport{status: Degraded}},
+		{name: "unknown", body: &HealthReport{Status: status(Unknown)}, want: healthReport{status: Unknown}},
+		{name: "severity", body: &HealthReport{Severity: severity(Major)}, want: healthReport{status: Down, severity: severity(Major)}},
+		{name: "body reason wins", body: &HealthReport{Reason: text("disk full")}, query: "timeout", want: healthReport{status: Down, reason: "disk full"}},
+		{name: "empty body reason", body: &HealthReport{Reason: text("")}, query: "timeout", want: healthReport{status: Down, reason: "timeout"}},
+		{name: "healthy", body: &HealthReport{Status: status(Healthy)}, errMsg: "status healthy is not accepted, mark the vertex healthy instead"},
+		{name: "bad status", body: &HealthReport{Status: status("broken")}, errMsg: `unknown status "broken"`},
+		{name: "bad severity", body: &HealthReport{Severity: severity("fatal")}, errMsg: `unknown severity "fatal"`},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got, err := unhealthyReport(c.body, c.query)
+			if c.errMsg != "" {
+				if err == nil || err.Error() != c.errMsg {
+					t.Fatalf("got error %v, want %q", err, c.errMsg)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.status != c.want.status || got.reason != c.want.reason || (got.severity == nil) != (c.want.severity == nil) ||
+				(got.severity != nil && *got.severity != *c.want.severity) {
+				t.Errorf("got %+v, want %+v", got, c.want)
+			}
+		})
+	}
+}
+
+func TestDegradedReport(t *testing.T) {
+	api := testAPI(t, "web>db")
+	ctx := context.Background()
+	degraded := Degraded
+	res, err := api.MarkVertexUnhealthy(ctx, MarkVertexUnhealthyRequestObject{Key: "db", JSONBody: &HealthReport{Status: &degraded}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := res.(MarkVertexUnhealthy200Response); !ok {
+		t.Fatalf("got %T", res)
+	}
+
+	// degraded keeps the vertex, and what depends on it, healthy
+	for _, k := range []string{"db", "web"} {
+		v, _ := api.graph.GetVertex(k)
+		if !v.Healthy {
+			t.Errorf("%s is unhealthy", k)
+		}
+	}
+	got, _ := api.GetVertex(ctx, GetVertexRequestObject{Key: "db"})
+	if v, ok := got.(GetVertex200JSONResponse); !ok || v.Status != Degraded || !v.Healthy {
+		t.Errorf("got %+v", got)
+	}
+	if _, failing := api.history.failing()["db"]; failing {
+		t.Error("a degraded vertex counts as failing")
+	}
+	if got := api.history.degraded(); len(got) != 1 || got[0] != "db" {
+		t.Errorf("got degraded %v", got)
+	}
+	h := api.history.between("db", nil, nil)
+	if len(h) != 1 || !h[0].Healthy || h[0].Status != Degraded || h[0].PreviousStatus != Healthy {
+		t.Errorf("got history %+v", h)
+	}
+
+	s := summarize(t, api, SummaryParams{})
+	if s.Statuses.Degraded != 1 || s.UnhealthyTotal != 0 || s.HealthyRatio != 1 {
+		t.Errorf("got summary %+v", s)
+	}
+
+	bad := Healthy
+	res, _ = api.MarkVertexUnhealthy(ctx, MarkVertexUnhealthyRequestObject{Key: "db", JSONBody: &HealthReport{Status: &bad}})
+	if _, ok := res.(MarkVertexUnhealthy422JSONResponse); !ok {
+		t.Errorf("got %T reporting healthy as unhealthy", res)
+	}
+}
@@ -0,0 +1,101 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/opsminded/graphlib/v2"
+)
+
+var defaultCriticalClasses = []string{"business_service"}
+
+func (api *API) GetVertexImpact(ctx context.Context, request GetVertexImpactRequestObject) (GetVertexImpactResponseObject, error) {
+	serviceSub, err := api.svc().VertexDependents(request.Key, true)
+	if errors.As(err, &graphlib.VertexNotFoundErr{}) {
+		nf := NotFoundJSONResponse{Code: 404, Error: err.Error()}
+		return GetVertexImpact404JSONResponse{NotFoundJSONResponse: nf}, nil
+	}
+	if err != nil {
+		ise := InternalServerErrorJSONResponse{Code: 500, Error: err.Error()}
+		return GetVertexImpact500JSONResponse{InternalServerErrorJSONResponse: ise}, nil
+	}
+
+	critical := map[string]struct{}{}
+	classes := defaultCriticalClasses
+	if request.Params.CriticalClass != nil && len(*request.Params.CriticalClass) > 0 {
+		classes = *request.Params.CriticalClass
+	}
+	for _, c := range classes {
+		critical[c] = struct{}{}
+	}
+
+	view := api.healthView()
+	sub := Subgraph{
+		Title:      "Impacto de " + request.Key,
+		All:        true,
+		Principal:  api.toVertex(serviceSub.Principal, view),
+		Edges:      api.toEdges(serviceSub.SubGraph.Edges),
+		Vertices:   api.toVertices(serviceSub.SubGraph.Vertices, view),
+		Highlights: []Vertex{},
+	}
+	distance := hops(request.Key, adjacency(sub.Edges, true))
+
+	impact := Impact{
+		ByClass:    []ImpactClassGroup{},
+		ByDistance: []ImpactDistanceGroup{},
+	}
+	byClass := map[string]*ImpactClassGroup{}
+	byDistance := map[int]*ImpactDistanceGroup{}
+	for _, v := range sub.Vertices {
+		if v.Key == request.Key {
+			continue
+		}
+		impact.Total++
+		if !v.Healthy {
+			impact.Unhealthy++
+		}
+		if _, ok := critical[v.Class]; ok {
+			impact.Critical++
+			sub.Highlights = append(sub.Highlights, v)
+		}
+
+		cg, ok := byClass[v.Class]
+		if !ok {
+			cg = &ImpactClassGroup{Class: v.Class, Keys: []string{}}
+			byClass[v.Class] = cg
+		}
+		cg.Count++
+		cg.Keys = append(cg.Keys, v.Key)
+
+		d := distance[v.Key]
+		dg, ok := byDistance[d]
+		if !ok {
+			dg = &ImpactDistanceGroup{Distance: d, Keys: []string{}}
+			byDistance[d] = dg
+		}
+		dg.Count++
+		dg.Keys = append(dg.Keys, v.Key)
+	}
+
+	for _, g := range byClass {
+		sort.Strings(g.Keys)
+		impact.ByClass = append(impact.ByClass, *g)
+	}
+	sort.Slice(impact.ByClass, func(i, j int) bool {
+		a, b := impact.ByClass[i], impact.ByClass[j]
+		if a.Count != b.Count {
+			return a.Count > b.Count
+		}
+		return a.Class < b.Class
+	})
+	for _, g := range byDistance {
+		sort.Strings(g.Keys)
+		impact.ByDistance = append(impact.ByDistance, *g)
+	}
+	sort.Slice(impact.ByDistance, func(i, j int) bool { return impact.ByDistance[i].Distance < impact.ByDistance[j].Distance })
+	sort.Slice(sub.Highlights, func(i, j int) bool { return sub.Highlights[i].Key < sub.Highlights[j].Key })
+
+	impact.Subgraph = sub
+	return GetVertexImpact200JSONResponse(impact), nil
+}
@@ -0,0 +1,327 @@
+package api
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"strings"
+
+	"github.com/opsminded/graphlib/v2"
+)
+
+// importVertex and importEdge keep the row of the item in the caller's
+// list, so errors point at it.
+type importVertex struct {
+	Row   int
+	Key   string
+	Label string
+	Class string
+}
+
+type importEdge struct {
+	Row    int
+	Source string
+	Target string
+	Class  string
+	Label  string
+}
+
+type importBatch struct {
+	vertices []importVertex
+	edges    []importEdge
+	errors   []ImportError
+}
+
+func importFromSubgraph(sub Subgraph) importBatch {
+	b := importBatch{}
+
+	// the principal is outside the list, so it is row 0
+	principal := sub.Principal.Key
+	if principal != "" {
+		v := sub.Principal
+		b.vertices = append(b.vertices, importVertex{Row: 0, Key: v.Key, Label: v.Label, Class: v.Class})
+	}
+	for i, v := range sub.Vertices {
+		// the principal usually repeats inside vertices, once
+		if principal != "" && v.Key == principal {
+			principal = ""
+			continue
+		}
+		b.vertices = append(b.vertices, importVertex{Row: i + 1, Key: v.Key, Label: v.Label, Class: v.Class})
+	}
+
+	for i, e := range sub.Edges {
+		b.edges = append(b.edges, importEdge{Row: i + 1, Source: e.Source, Target: e.Target, Class: e.Class, Label: e.Label})
+	}
+	return b
+}
+
+func importFromCSV(mr *multipart.Reader) (importBatch, error) {
+	b := importBatch{}
+
+	for {
+		part, err := mr.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return b, err
+		}
+
+		switch part.FormName() {
+		case "vertices":
+			err = readCSV(part, "vertex", []string{"key", "label", "class"}, func(n int, row map[string]string) {
+				b.vertices = append(b.vertices, importVertex{Row: n, Key: row["key"], Label: row["label"], Class: row["class"]})
+			}, &b.errors)
+		case "edges":
+			err = readCSV(part, "edge", []string{"source", "target", "class", "label"}, func(n int, row map[string]string) {
+				b.edges = append(b.edges, importEdge{Row: n, Source: row["source"], Target: row["target"], Class: row["class"], Label: row["label"]})
+			}, &b.errors)
+		}
+		if err != nil {
+			return b, fmt.Errorf("%s: %w", part.FormName(), err)
+		}
+	}
+	return b, nil
+}
+
+// readCSV reads a CSV with a header line and hands fn each row with its
+// number, the header not counted. Rows that do not have all the required
+// columns are reported and skipped; malformed files fail entirely.
+func readCSV(r io.Reader, kind string, required []string, fn func(int, map[string]string), report *[]ImportError) error {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	cr.TrimLeadingSpace = true
+
+	header, err := cr.Read()
+	if err == io.EOF {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	cols := make(map[string]int, len(header))
+	for i, h := range header {
+		cols[strings.ToLower(strings.TrimSpace(h))] = i
+	}
+	for _, c := range required {
+		if _, ok := cols[c]; !ok {
+			return fmt.Errorf("missing column %q", c)
+		}
+	}
+
+	for n := 1; ; n++ {
+		rec, err := cr.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		row := make(map[string]string, len(cols))
+		for name, i := range cols {
+			if i < len(rec) {
+				row[name] = strings.TrimSpace(rec[i])
+			}
+		}
+		if len(rec) < len(header) {
+			key := row["key"]
+			if kind == "edge" && row["source"] != "" && row["target"] != "" {
+				key = edgeKey(row["source"], row["target"])
+			}
+			*report = append(*report, ImportError{Kind: kind, Row: n, Key: key, Error: fmt.Sprintf("expected %d columns, found %d", len(header), len(rec))})
+			continue
+		}
+		fn(n, row)
+	}
+}
+
+func importFromGraphML(r io.Reader) (importBatch, error) {
+	b := importBatch{}
+
+	var doc graphML
+	if err := xml.NewDecoder(r).Decode(&doc); err != nil {
+		return b, err
+	}
+
+	for i, n := range doc.Graph.Nodes {
+		attrs := doc.attrs("node", n.Data)
+		label := attrs["label"]
+		if label == "" {
+			label = n.ID
+		}
+		b.vertices = append(b.vertices, importVertex{Row: i + 1, Key: n.ID, Label: label, Class: attrs["class"]})
+	}
+
+	for i, e := range doc.Graph.Edges {
+		attrs := doc.attrs("edge", e.Data)
+		b.edges = append(b.edges, importEdge{Row: i + 1, Source: e.Source, Target: e.Target, Class: attrs["class"], Label: attrs["label"]})
+	}
+	return b, nil
+}
+
+// applyImport merges or replaces the catalog with the batch. Edges are
+// validated against a scratch graph so cycles are reported per row.
+func (api *API) applyImport(mode ImportGraphParamsMode, b importBatch) (ImportReport, error) {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	report := ImportReport{
+		Mode:   string(mode),
+		Errors: append([]ImportError{}, b.errors...),
+	}
+	reject := func(kind string, row int, key, msg string) {
+		report.Errors = append(report.Errors, ImportError{Kind: kind, Row: row, Key: key, Error: msg})
+	}
+
+	next := newCatalog()
+	if mode == Upsert {
+		next = api.catalog.clone()
+	}
+
+	imported := make(map[string]int, len(b.vertices))
+	for _, v := range b.vertices {
+		row := v.Row
+		if v.Key == "" || v.Label == "" || v.Class == "" {
+			reject("vertex", row, v.Key, "key, label and class are required")
+			continue
+		}
+		if prev, dup := imported[v.Key]; dup {
+			reject("vertex", row, v.Key, fmt.Sprintf("vertex %q repeats row %d", v.Key, prev))
+			continue
+		}
+		imported[v.Key] = row
+
+		old, existed := api.catalog.vertices[v.Key]
+		next.vertices[v.Key] = vertexRecord{Key: v.Key, Label: v.Label, Class: v.Class}
+		if !existed {
+			report.VerticesCreated++
+		} else if old.Label != v.Label || old.Class != v.Class {
+			report.VerticesUpdated++
+		}
+	}
+
+	g := graphlib.NewSoAGraph(api.logger)
+	for _, k := range next.vertexKeys() {
+		v := next.vertices[k]
+		g.AddVertex(v.Key, v.Label, v.Class, true)
+	}
+	for _, k := range next.edgeKeys() {
+		e := next.edges[k]
+		if err := g.AddEdge(e.Source, e.Target); err != nil {
+			return report, err
+		}
+	}
+
+	importedEdges := make(map[string]int, len(b.edges))
+	for _, e := range b.edges {
+		row := e.Row
+		k := edgeKey(e.Source, e.Target)
+		if e.Source == "" || e.Target == "" || e.Class == "" || e.Label == "" {
+			reject("edge", row, k, "source, target, class and label are required")
+			continue
+		}
+		if prev, dup := importedEdges[k]; dup {
+			reject("edge", row, k, fmt.Sprintf("edge %q repeats row %d", k, prev))
+			continue
+		}
+
+		unknown := ""
+		for _, vk := range []string{e.Source, e.Target} {
+			if _, ok := next.vertices[vk]; !ok && unknown == "" {
+				unknown = vk
+			}
+		}
+		if unknown != "" {
+			reject("edge", row, k, fmt.Sprintf("edge references unknown vertex %q", unknown))
+			continue
+		}
+
+		rec := edgeRecord{Key: k, Source: e.Source, Target: e.Target, Class: e.Class, Label: e.Label}
+		if _, ok := next.edges[k]; !ok {
+			if err := g.AddEdge(e.Source, e.Target); err != nil {
+				reject("edge", row, k, err.Error())
+				continue
+			}
+		}
+		importedEdges[k] = row
+		next.edges[k] = rec
+
+		old, existed := api.catalog.edges[k]
+		if !existed {
+			report.EdgesCreated++
+		} else if old.Class != e.Class || old.Label != e.Label {
+			report.EdgesUpdated++
+		}
+	}
+
+	removed := []string{}
+	if mode == Replace {
+		for k := range api.catalog.vertices {
+			if _, ok := next.vertices[k]; !ok {
+				removed = append(removed, k)
+			}
+		}
+		for k := range api.catalog.edges {
+			if _, ok := next.edges[k]; !ok {
+				report.EdgesRemoved++
+			}
+		}
+	}
+	report.VerticesRemoved = len(removed)
+
+	if err := api.rebuild(next); err != nil {
+		return report, err
+	}
+	for _, k := range removed {
+		api.attributes.remove(k, nil)
+		api.history.remove(k)
+		api.propagation.remove(k)
+	}
+	api.events.publish(Event{Type: "graph.imported"})
+	api.announceHealthLocked()
+	return report, nil
+}
+
+func (api *API) ImportGraph(ctx context.Context, request ImportGraphRequestObject) (ImportGraphResponseObject, error) {
+	mode := Upsert
+	if request.Params.Mode != nil {
+		mode = *request.Params.Mode
+	}
+	if mode != Upsert && mode != Replace {
+		ir := InvalidRequestJSONResponse{Code: 422, Error: fmt.Sprintf("unknown import mode %q", mode)}
+		return ImportGraph422JSONResponse{InvalidRequestJSONResponse: ir}, nil
+	}
+
+	var (
+		batch importBatch
+		err   error
+	)
+	switch {
+	case request.JSONBody != nil:
+		batch = importFromSubgraph(*request.JSONBody)
+	case request.MultipartBody != nil:
+		batch, err = importFromCSV(request.MultipartBody)
+	case request.Body != nil:
+		batch, err = importFromGraphML(request.Body)
+	default:
+		err = errors.New("unsupported content type")
+	}
+	if err != nil {
+		ir := InvalidRequestJSONResponse{Code: 422, Error: err.Error()}
+		return ImportGraph422JSONResponse{InvalidRequestJSONResponse: ir}, nil
+	}
+
+	report, err := api.applyImport(mode, batch)
+	if err != nil {
+		ise := InternalServerErrorJSONResponse{Code: 500, Error: err.Error()}
+		return ImportGraph500JSONResponse{InternalServerErrorJSONResponse: ise}, nil
+	}
+	return ImportGraph200JSONResponse(report), nil
+}
@@ -0,0 +1,261 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"mime/multipart"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func importJSON(t *testing.T, api *API, mode ImportGraphParamsMode, sub Subgraph) ImportReport {
+	t.Helper()
+	res, err := api.ImportGraph(context.Background(), ImportGraphRequestObject{
+		Params:   ImportGraphParams{Mode: &mode},
+		JSONBody: &sub,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, ok := res.(ImportGraph200JSONResponse)
+	if !ok {
+		t.Fatalf("got %+v", res)
+	}
+	return ImportReport(r)
+}
+
+func testVertex(key, class string) Vertex {
+	return Vertex{Key: key, Label: strings.ToUpper(key), Class: class}
+}
+
+func testEdge(src, tgt string) Edge {
+	return Edge{Source: src, Target: tgt, Class: "runs-on", Label: "usa"}
+}
+
+func TestImportUpsert(t *testing.T) {
+	api := testAPI(t, "web>db")
+
+	r := importJSON(t, api, Upsert, Subgraph{
+		Vertices: []Vertex{testVertex("db", "database"), testVertex("web", "server"), testVertex("cache", "cache")},
+		Edges:    []Edge{testEdge("web", "db"), testEdge("web", "cache")},
+	})
+	// web->db is unchanged
+	want := ImportReport{Mode: "upsert", Errors: []ImportError{}, VerticesCreated: 1, VerticesUpdated: 2, EdgesCreated: 1}
+	if !reflect.DeepEqual(r, want) {
+		t.Errorf("got %+v, want %+v", r, want)
+	}
+	if got := api.catalog.vertices["db"]; got.Class != "database" || got.Label != "DB" {
+		t.Errorf("db is %+v", got)
+	}
+	if _, err := api.graph.GetVertex("cache"); err != nil {
+		t.Error(err)
+	}
+	if len(api.catalog.edges) != 2 {
+		t.Errorf("got edges %v", api.catalog.edgeKeys())
+	}
+}
+
+func TestImportRowErrors(t *testing.T) {
+	api := testAPI(t, "web>db")
+
+	r := importJSON(t, api, Upsert, Subgraph{
+		Principal: testVertex("app", "server"),
+		Vertices: []Vertex{
+			testVertex("app", "server"),
+			{Key: "broken", Label: "Broken"},
+			testVertex("queue", "queue"),
+			testVertex("queue", "queue"),
+		},
+		Edges: []Edge{
+			testEdge("app", "web"),
+			testEdge("db", "app"),
+			testEdge("app", "cache"),
+			{Source: "app", Target: "queue"},
+			testEdge("app", "queue"),
+			testEdge("app", "queue"),
+		},
+	})
+
+	got := map[string]ImportError{}
+	for _, e := range r.Errors {
+		got[e.Kind+" "+e.Key] = e
+	}
+	want := []struct {
+		kind, key string
+		row       int
+		contains  string
+	}{
+		{kind: "vertex", key: "broken", row: 2, contains: "required"},
+		{kind: "vertex", key: "queue", row: 4, contains: "repeats row 3"},
+		{kind: "edge", key: "db->app", row: 2, contains: "cycle"},
+		{kind: "edge", key: "app->cache", row: 3, contains: `unknown vertex "cache"`},
+		{kind: "edge", key: "app->queue", row: 4, contains: "required"},
+		{kind: "edge", key: "app->queue", row: 6, contains: "repeats row 5"},
+	}
+	if len(r.Errors) != len(want) {
+		t.Errorf("got %d errors: %+v", len(r.Errors), r.Errors)
+	}
+	for _, w := range want {
+		found := false
+		for _, e := range r.Errors {
+			if e.Kind == w.kind && e.Key == w.key && e.Row == w.row && strings.Contains(e.Error, w.contains) {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("no %s error at row %d with %q, got %+v", w.kind, w.row, w.contains, got)
+		}
+	}
+
+	// the rows that were fine are imported
+	if r.VerticesCreated != 2 || r.EdgesCreated != 2 {
+		t.Errorf("got %+v", r)
+	}
+	if _, err := api.graph.VertexDependencies("db", true); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestImportReplace(t *testing.T) {
+	api := testAPI(t, "web>db", "app>web")
+	ctx := context.Background()
+	api.attributes.replace("app", VertexAttrubutes{{Description: "owner", Type: "string"}})
+	if _, err := api.MarkVertexUnhealthy(ctx, MarkVertexUnhealthyRequestObject{Key: "app"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := api.MarkVertexUnhealthy(ctx, MarkVertexUnhealthyRequestObject{Key: "db"}); err != nil {
+		t.Fatal(err)
+	}
+	api.propagation.setVertex("app", PropagationPolicy{Mode: Ignore})
+
+	r := importJSON(t, api, Replace, Subgraph{
+		Vertices: []Vertex{testVertex("web", "server"), testVertex("db", "server"), testVertex("cache", "cache")},
+		Edges:    []Edge{testEdge("web", "db")},
+	})
+	if r.VerticesRemoved != 1 || r.EdgesRemoved != 1 || r.VerticesCreated != 1 {
+		t.Errorf("got %+v", r)
+	}
+
+	if _, err := api.graph.GetVertex("app"); err == nil {
+		t.Error("app is still in the graph")
+	}
+	if len(api.attributes.get("app")) != 0 {
+		t.Error("the attributes of app were kept")
+	}
+	if len(api.history.between("app", nil, nil)) != 0 {
+		t.Error("the history of app was kept")
+	}
+	if res := api.propagation.resolve("app", "server"); res.Source != ResolvedPropagationSourceDefault {
+		t.Errorf("the policy of app was kept: %+v", res)
+	}
+
+	// the vertices that survive keep their health and their history
+	if v, _ := api.graph.GetVertex("db"); v.Healthy {
+		t.Error("db lost its health")
+	}
+	if len(api.history.between("db", nil, nil)) != 1 {
+		t.Error("db lost its history")
+	}
+}
+
+func TestImportCSV(t *testing.T) {
+	body := &bytes.Buffer{}
+	w := multipart.NewWriter(body)
+	part, _ := w.CreateFormFile("vertices", "vertices.csv")
+	part.Write([]byte("key,label,class\nweb,Web,server\ndb,DB\ndb,DB,database\n"))
+	part, _ = w.CreateFormFile("edges", "edges.csv")
+	part.Write([]byte("source, target, class, label\nweb,db,runs-on,usa\nweb,cache\n"))
+	w.Close()
+
+	api := testAPI(t)
+	mode := Upsert
+	res, err := api.ImportGraph(context.Background(), ImportGraphRequestObject{
+		Params:        ImportGraphParams{Mode: &mode},
+		MultipartBody: multipart.NewReader(body, w.Boundary()),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, ok := res.(ImportGraph200JSONResponse)
+	if !ok {
+		t.Fatalf("got %+v", res)
+	}
+
+	if r.VerticesCreated != 2 || r.EdgesCreated != 1 {
+		t.Errorf("got %+v", r)
+	}
+	want := []ImportError{
+		{Kind: "vertex", Row: 2, Key: "db", Error: "expected 3 columns, found 2"},
+		{Kind: "edge", Row: 2, Key: "web->cache", Error: "expected 4 columns, found 2"},
+	}
+	if !reflect.DeepEqual(r.Errors, want) {
+		t.Errorf("got errors %+v, want %+v", r.Errors, want)
+	}
+	if got := api.catalog.edges["web->db"]; got.Class != "runs-on" || got.Label != "usa" {
+		t.Errorf("got edge %+v", got)
+	}
+}
+
+func TestImportCSVMissingColumn(t *testing.T) {
+	body := &bytes.Buffer{}
+	w := multipart.NewWriter(body)
+	part, _ := w.CreateFormFile("vertices", "vertices.csv")
+	part.Write([]byte("key,label\nweb,Web\n"))
+	w.Close()
+
+	api := testAPI(t)
+	res, err := api.ImportGraph(context.Background(), ImportGraphRequestObject{MultipartBody: multipart.NewReader(body, w.Boundary())})
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, ok := res.(ImportGraph422JSONResponse)
+	if !ok || r.Error != `vertices: missing column "class"` {
+		t.Errorf("got %+v", res)
+	}
+}
+
+func TestImportGraphML(t *testing.T) {
+	doc := `<?xml version="1.0" encoding="UTF-8"?>
+<graphml xmlns="http://graphml.graphdrawing.org/xmlns">
+  <key id="d0" for="node" attr.name="label" attr.type="string"/>
+  <key id="d1" for="node" attr.name="class" attr.type="string"/>
+  <key id="d2" for="edge" attr.name="class" attr.type="string"/>
+  <key id="d3" for="edge" attr.name="label" attr.type="string"/>
+  <graph edgedefault="directed">
+    <node id="web"><data key="d0">Web</data><data key="d1">server</data></node>
+    <node id="db"><data key="d1">database</data></node>
+    <edge source="web" target="db"><data key="d2">runs-on</data><data key="d3">usa</data></edge>
+  </graph>
+</graphml>`
+
+	api := testAPI(t)
+	res, err := api.ImportGraph(context.Background(), ImportGraphRequestObject{Body: strings.NewReader(doc)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, ok := res.(ImportGraph200JSONResponse)
+	if !ok {
+		t.Fatalf("got %+v", res)
+	}
+	if r.Mode != "upsert" || r.VerticesCreated != 2 || r.EdgesCreated != 1 || len(r.Errors) != 0 {
+		t.Errorf("got %+v", r)
+	}
+
+	// a node without a label is named after its id
+	want := map[string]vertexRecord{
+		"web": {Key: "web", Label: "Web", Class: "server"},
+		"db":  {Key: "db", Label: "db", Class: "database"},
+	}
+	if !reflect.DeepEqual(api.catalog.vertices, want) {
+		t.Errorf("got %+v", api.catalog.vertices)
+	}
+	if got := api.catalog.edges["web->db"]; got.Class != "runs-on" || got.Label != "usa" {
+		t.Errorf("got edge %+v", got)
+	}
+
+	res, _ = api.ImportGraph(context.Background(), ImportGraphRequestObject{Body: strings.NewReader("<graphml>")})
+	if _, ok := res.(ImportGraph422JSONResponse); !ok {
+		t.Errorf("got %T for a truncated document", res)
+	}
+}
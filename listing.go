@@ -0,0 +1,209 @@
+package api
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const (
+	defaultPageSize = 50
+	maxPageSize     = 500
+)
+
+// listCursor points after the last vertex of a page. It carries the sort
+// so a cursor cannot be reused with a different ordering.
+type listCursor struct {
+	Sort  string `json:"s"`
+	Value string `json:"v"`
+	Key   string `json:"k"`
+}
+
+func encodeCursor(c listCursor) string {
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodeCursor(s string) (listCursor, error) {
+	c := listCursor{}
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return c, errors.New("malformed cursor")
+	}
+	if err := json.Unmarshal(b, &c); err != nil {
+		return c, errors.New("malformed cursor")
+	}
+	return c, nil
+}
+
+type attributeFilter struct {
+	description string
+	value       string
+}
+
+func parseAttributeFilters(params []string) ([]attributeFilter, error) {
+	filters := []attributeFilter{}
+	for _, p := range params {
+		d, v, ok := strings.Cut(p, "=")
+		if !ok || d == "" {
+			return nil, fmt.Errorf("attribute filter %q must be description=value", p)
+		}
+		filters = append(filters, attributeFilter{description: d, value: v})
+	}
+	return filters, nil
+}
+
+func (api *API) matchesAttributes(key string, filters []attributeFilter) bool {
+	if len(filters) == 0 {
+		return true
+	}
+	attrs := api.attributes.get(key)
+	for _, f := range filters {
+		found := false
+		for _, a := range attrs {
+			if a.Description == f.description && attributeText(a) == f.value {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func (api *API) ListVertices(ctx context.Context, request ListVerticesRequestObject) (ListVerticesResponseObject, error) {
+	params := request.Params
+
+	sortBy := "key"
+	if params.Sort != nil && *params.Sort != "" {
+		sortBy = *params.Sort
+	}
+	if sortBy != "key" && sortBy != "label" {
+		ir := InvalidRequestJSONResponse{Code: 422, Error: fmt.Sprintf("unknown sort %q", sortBy)}
+		return ListVertices422JSONResponse{InvalidRequestJSONResponse: ir}, nil
+	}
+
+	limit := defaultPageSize
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+	if limit < 1 || limit > maxPageSize {
+		ir := InvalidRequestJSONResponse{Code: 422, Error: fmt.Sprintf("limit must be between 1 and %d", maxPageSize)}
+		return ListVertices422JSONResponse{InvalidRequestJSONResponse: ir}, nil
+	}
+
+	var after *listCursor
+	if params.Cursor != nil && *params.Cursor != "" {
+		c, err := decodeCursor(*params.Cursor)
+		if err == nil && c.Sort != sortBy {
+			err = fmt.Errorf("cursor was issued for sort %q", c.Sort)
+		}
+		if err != nil {
+			ir := InvalidRequestJSONResponse{Code: 422, Error: err.Error()}
+			return ListVertices422JSONResponse{InvalidRequestJSONResponse: ir}, nil
+		}
+		after = &c
+	}
+
+	if o := params.Origin; o != nil && *o != Direct && *o != Inherited {
+		ir := InvalidRequestJSONResponse{Code: 422, Error: fmt.Sprintf("unknown health origin %q", *o)}
+		return ListVertices422JSONResponse{InvalidRequestJSONResponse: ir}, nil
+	}
+
+	var attrFilters []attributeFilter
+	if params.Attribute != nil {
+		var err error
+		if attrFilters, err = parseAttributeFilters(*params.Attribute); err != nil {
+			ir := InvalidRequestJSONResponse{Code: 422, Error: err.Error()}
+			return ListVertices422JSONResponse{InvalidRequestJSONResponse: ir}, nil
+		}
+	}
+
+	classes := map[string]struct{}{}
+	if params.Class != nil {
+		for _, c := range *params.Class {
+			classes[c] = struct{}{}
+		}
+	}
+	label := ""
+	if params.Label != nil {
+		label = strings.ToLower(*params.Label)
+	}
+
+	api.mu.RLock()
+	matched := []Vertex{}
+	view := api.healthViewLocked()
+	for _, k := range api.catalog.vertexKeys() {
+		gv, err := api.graph.GetVertex(k)
+		if err != nil {
+			continue
+		}
+		v := api.toVertex(gv, view)
+		if _, ok := classes[v.Class]; len(classes) > 0 && !ok {
+			continue
+		}
+		if params.Healthy != nil && v.Healthy != *params.Healthy {
+			continue
+		}
+		if label != "" && !strings.Contains(strings.ToLower(v.Label), label) {
+			continue
+		}
+		if params.Origin != nil && v.HealthOrigin != *params.Origin {
+			continue
+		}
+		matched = append(matched, v)
+	}
+	api.mu.RUnlock()
+
+	filtered := matched[:0]
+	for _, v := range matched {
+		if api.matchesAttributes(v.Key, attrFilters) {
+			filtered = append(filtered, v)
+		}
+	}
+
+	sortValue := func(v Vertex) string {
+		if sortBy == "label" {
+			return v.Label
+		}
+		return v.Key
+	}
+	// the key breaks ties so the order is total and cursors are stable
+	sort.Slice(filtered, func(i, j int) bool {
+		a, b := sortValue(filtered[i]), sortValue(filtered[j])
+		if a != b {
+			return a < b
+		}
+		return filtered[i].Key < filtered[j].Key
+	})
+
+	start := 0
+	if after != nil {
+		start = sort.Search(len(filtered), func(i int) bool {
+			v := sortValue(filtered[i])
+			return v > after.Value || (v == after.Value && filtered[i].Key > after.Key)
+		})
+	}
+	end := start + limit
+	if end > len(filtered) {
+		end = len(filtered)
+	}
+
+	page := VertexPage{
+		Items: append([]Vertex{}, filtered[start:end]...),
+		Total: len(filtered),
+	}
+	if end < len(filtered) {
+		last := filtered[end-1]
+		next := encodeCursor(listCursor{Sort: sortBy, Value: sortValue(last), Key: last.Key})
+		page.NextCursor = &next
+	}
+
+	return ListVertices200JSONResponse(page), nil
+}
@@ -0,0 +1,104 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"testing"
+)
+
+func TestCursor(t *testing.T) {
+	c := listCursor{Sort: "label", Value: "DB prod 01", Key: "db-prod-01"}
+	got, err := decodeCursor(encodeCursor(c))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != c {
+		t.Errorf("got %+v, want %+v", got, c)
+	}
+
+	for _, s := range []string{"!!", "bm90IGpzb24"} {
+		if _, err := decodeCursor(s); err == nil || err.Error() != "malformed cursor" {
+			t.Errorf("%q: got error %v, want malformed cursor", s, err)
+		}
+	}
+}
+
+func TestListVerticesPages(t *testing.T) {
+	api := New(slog.New(slog.NewTextHandler(io.Discard, nil)))
+	for i := 0; i < 7; i++ {
+		// labels repeat so the key has to break the ties
+		api.AddVertex(fmt.Sprintf("v%d", i), fmt.Sprintf("label %d", i%3), "server", true)
+	}
+
+	for _, sortBy := range []string{"key", "label"} {
+		t.Run(sortBy, func(t *testing.T) {
+			seen := map[string]int{}
+			order := []string{}
+			limit := 3
+			var cursor *string
+			for pages := 0; ; pages++ {
+				if pages > 3 {
+					t.Fatal("too many pages")
+				}
+				sb := sortBy
+				res, err := api.ListVertices(context.Background(), ListVerticesRequestObject{Params: ListVerticesParams{
+					Sort:   &sb,
+					Limit:  &limit,
+					Cursor: cursor,
+				}})
+				if err != nil {
+					t.Fatal(err)
+				}
+				page, ok := res.(ListVertices200JSONResponse)
+				if !ok {
+					t.Fatalf("got %T", res)
+				}
+				if page.Total != 7 {
+					t.Errorf("got total %d, want 7", page.Total)
+				}
+				for _, v := range page.Items {
+					seen[v.Key]++
+					order = append(order, v.Key)
+				}
+				if page.NextCursor == nil {
+					break
+				}
+				cursor = page.NextCursor
+			}
+
+			if len(seen) != 7 {
+				t.Errorf("got %v, want every vertex", order)
+			}
+			for k, n := range seen {
+				if n != 1 {
+					t.Errorf("%s listed %d times", k, n)
+				}
+			}
+			want := []string{"v0", "v1", "v2", "v3", "v4", "v5", "v6"}
+			if sortBy == "label" {
+				want = []string{"v0", "v3", "v6", "v1", "v4", "v2", "v5"}
+			}
+			if fmt.Sprint(order) != fmt.Sprint(want) {
+				t.Errorf("got order %v, want %v", order, want)
+			}
+		})
+	}
+
+	// a cursor only applies to the sort it was issued for
+	sortBy, limit := "key", 3
+	res, err := api.ListVertices(context.Background(), ListVerticesRequestObject{Params: ListVerticesParams{Sort: &sortBy, Limit: &limit}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	next := res.(ListVertices200JSONResponse).NextCursor
+	other := "label"
+	res, err = api.ListVertices(context.Background(), ListVerticesRequestObject{Params: ListVerticesParams{Sort: &other, Cursor: next}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := res.(ListVertices422JSONResponse); !ok {
+		t.Errorf("got %T for a cursor of another sort", res)
+	}
+}
@@ -0,0 +1,304 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/opsminded/graphlib/v2"
+)
+
+const (
+	defaultPathCount = 3
+	maxPathCount     = 50
+	// maxShortestPaths caps all-shortest, as the number of paths of the same
+	// length can grow exponentially.
+	maxShortestPaths = 100
+)
+
+// pathFinder searches paths following the dependencies. blocked vertices
+// may not be used between the endpoints.
+type pathFinder struct {
+	next    map[string][]string
+	prev    map[string][]string
+	blocked map[string]struct{}
+}
+
+func (f pathFinder) usable(n, dst string) bool {
+	_, blocked := f.blocked[n]
+	return !blocked || n == dst
+}
+
+// shortest returns one shortest path from src to dst that uses none of the
+// skipped vertices and edges, or nil.
+func (f pathFinder) shortest(src, dst string, skipVertices, skipEdges map[string]struct{}) []string {
+	prev := map[string]string{src: ""}
+	queue := []string{src}
+	for len(queue) > 0 && queue[0] != dst {
+		n := queue[0]
+		queue = queue[1:]
+		for _, m := range f.next[n] {
+			if _, seen := prev[m]; seen || !f.usable(m, dst) {
+				continue
+			}
+			if _, skip := skipVertices[m]; skip {
+				continue
+			}
+			if _, skip := skipEdges[edgeKey(n, m)]; skip {
+				continue
+			}
+			prev[m] = n
+			queue = append(queue, m)
+		}
+	}
+	if _, ok := prev[dst]; !ok {
+		return nil
+	}
+
+	path := []string{}
+	for n := dst; n != ""; n = prev[n] {
+		path = append([]string{n}, path...)
+	}
+	return path
+}
+
+// distances returns the hop distance from root through adj, honouring
+// blocked vertices.
+func (f pathFinder) distances(root, dst string, adj map[string][]string) map[string]int {
+	dist := map[string]int{root: 0}
+	queue := []string{root}
+	for len(queue) > 0 {
+		n := queue[0]
+		queue = queue[1:]
+		for _, m := range adj[n] {
+			if _, seen := dist[m]; seen || (!f.usable(m, dst) && m != root) {
+				continue
+			}
+			dist[m] = dist[n] + 1
+			queue = append(queue, m)
+		}
+	}
+	return dist
+}
+
+// allShortest returns every shortest path from src to dst, up to limit.
+func (f pathFinder) allShortest(src, dst string, limit int) [][]string {
+	from := f.distances(src, dst, f.next)
+	to := f.distances(dst, src, f.prev)
+	length, ok := from[dst]
+	if !ok {
+		return [][]string{}
+	}
+
+	paths := [][]string{}
+	var walk func(n string, path []string)
+	walk = func(n string, path []string) {
+		if len(paths) >= limit {
+			return
+		}
+		path = append(path, n)
+		if n == dst {
+			paths = append(paths, append([]string{}, path...))
+			return
+		}
+		for _, m := range f.next[n] {
+			dm, ok1 := from[m]
+			tm, ok2 := to[m]
+			if ok1 && ok2 && dm == from[n]+1 && dm+tm == length {
+				walk(m, path)
+			}
+		}
+	}
+	walk(src, nil)
+	return paths
+}
+
+// kShortest returns up to k shortest simple paths from src to dst using
+// Yen's algorithm.
+func (f pathFinder) kShortest(src, dst string, k int) [][]string {
+	first := f.shortest(src, dst, nil, nil)
+	if first == nil {
+		return [][]string{}
+	}
+
+	found := [][]string{first}
+	known := map[string]struct{}{strings.Join(first, "\x00"): {}}
+	candidates := [][]string{}
+	for len(found) < k {
+		last := found[len(found)-1]
+		for i := 0; i < len(last)-1; i++ {
+			root := last[:i+1]
+
+			skipEdges := map[string]struct{}{}
+			for _, p := range found {
+				if len(p) > i+1 && strings.Join(p[:i+1], "\x00") == strings.Join(root, "\x00") {
+					skipEdges[edgeKey(p[i], p[i+1])] = struct{}{}
+				}
+			}
+			skipVertices := map[string]struct{}{}
+			for _, n := range root[:i] {
+				skipVertices[n] = struct{}{}
+			}
+
+			spur := f.shortest(last[i], dst, skipVertices, skipEdges)
+			if spur == nil {
+				continue
+			}
+			path := append(append([]string{}, root[:i]...), spur...)
+			id := strings.Join(path, "\x00")
+			if _, dup := known[id]; dup {
+				continue
+			}
+			known[id] = struct{}{}
+			candidates = append(candidates, path)
+		}
+		if len(candidates) == 0 {
+			break
+		}
+
+		sort.SliceStable(candidates, func(i, j int) bool { return len(candidates[i]) < len(candidates[j]) })
+		found = append(found, candidates[0])
+		candidates = candidates[1:]
+	}
+	return found
+}
+
+// toGraphPath turns a list of keys into ordered hops.
+func toGraphPath(path []string, vertices map[string]Vertex, edges map[string]Edge) GraphPath {
+	gp := GraphPath{
+		Length:  len(path) - 1,
+		Healthy: true,
+		Hops:    []PathHop{},
+	}
+	for i, k := range path {
+		hop := PathHop{Index: i, Vertex: vertices[k], Distance: i}
+		if i+1 < len(path) {
+			e := edges[edgeKey(k, path[i+1])]
+			hop.Edge = &e
+		}
+		if !hop.Vertex.Healthy {
+			gp.Healthy = false
+		}
+		gp.Hops = append(gp.Hops, hop)
+	}
+	return gp
+}
+
+// orderedPath adds to sub the hops of the shortest of the paths it holds.
+func (api *API) orderedPath(sub Subgraph, src, dst string) Path {
+	vertices := map[string]Vertex{sub.Principal.Key: sub.Principal}
+	for _, v := range sub.Vertices {
+		vertices[v.Key] = v
+	}
+	edges := make(map[string]Edge, len(sub.Edges))
+	for _, e := range sub.Edges {
+		edges[edgeKey(e.Source, e.Target)] = e
+	}
+
+	finder := pathFinder{next: adjacency(sub.Edges, false)}
+	hops := []PathHop{}
+	if p := finder.shortest(src, dst, nil, nil); p != nil {
+		hops = toGraphPath(p, vertices, edges).Hops
+	}
+
+	return Path{
+		Title:      sub.Title,
+		All:        sub.All,
+		Principal:  sub.Principal,
+		Vertices:   sub.Vertices,
+		Edges:      sub.Edges,
+		Highlights: sub.Highlights,
+		Hops:       hops,
+	}
+}
+
+// graphSnapshot copies every vertex and edge of the catalog.
+func (api *API) graphSnapshot() (map[string]Vertex, map[string]Edge) {
+	api.mu.RLock()
+	defer api.mu.RUnlock()
+
+	view := api.healthViewLocked()
+	vertices := make(map[string]Vertex, len(api.catalog.vertices))
+	for k := range api.catalog.vertices {
+		if v, err := api.graph.GetVertex(k); err == nil {
+			vertices[k] = api.toVertex(v, view)
+		}
+	}
+	edges := make(map[string]Edge, len(api.catalog.edges))
+	for k, e := range api.catalog.edges {
+		edges[k] = toEdge(e)
+	}
+	return vertices, edges
+}
+
+func (api *API) GetPaths(ctx context.Context, request GetPathsRequestObject) (GetPathsResponseObject, error) {
+	params := request.Params
+
+	mode := "all-shortest"
+	if params.Mode != nil && *params.Mode != "" {
+		mode = *params.Mode
+	}
+	if mode != "all-shortest" && mode != "k-shortest" {
+		ir := InvalidRequestJSONResponse{Code: 422, Error: fmt.Sprintf("unknown path mode %q", mode)}
+		return GetPaths422JSONResponse{InvalidRequestJSONResponse: ir}, nil
+	}
+	k := defaultPathCount
+	if params.K != nil {
+		k = *params.K
+	}
+	if k < 1 || k > maxPathCount {
+		ir := InvalidRequestJSONResponse{Code: 422, Error: fmt.Sprintf("k must be between 1 and %d", maxPathCount)}
+		return GetPaths422JSONResponse{InvalidRequestJSONResponse: ir}, nil
+	}
+
+	vertices, edges := api.graphSnapshot()
+	for _, key := range []string{request.Key, request.Target} {
+		if _, ok := vertices[key]; !ok {
+			nf := NotFoundJSONResponse{Code: 404, Error: graphlib.VertexNotFoundErr{Key: key}.Error()}
+			return GetPaths404JSONResponse{NotFoundJSONResponse: nf}, nil
+		}
+	}
+
+	avoid := map[string]struct{}{}
+	if params.AvoidClass != nil {
+		for _, c := range *params.AvoidClass {
+			avoid[c] = struct{}{}
+		}
+	}
+	avoidUnhealthy := params.AvoidUnhealthy != nil && *params.AvoidUnhealthy
+
+	all := make([]Edge, 0, len(edges))
+	for _, e := range edges {
+		all = append(all, e)
+	}
+	finder := pathFinder{
+		next:    adjacency(all, false),
+		prev:    adjacency(all, true),
+		blocked: map[string]struct{}{},
+	}
+	for key, v := range vertices {
+		_, avoided := avoid[v.Class]
+		if avoided || (avoidUnhealthy && !v.Healthy) {
+			finder.blocked[key] = struct{}{}
+		}
+	}
+
+	var found [][]string
+	if mode == "k-shortest" {
+		found = finder.kShortest(request.Key, request.Target, k)
+	} else {
+		found = finder.allShortest(request.Key, request.Target, maxShortestPaths)
+	}
+
+	res := Paths{
+		Source: request.Key,
+		Target: request.Target,
+		Mode:   mode,
+		Paths:  []GraphPath{},
+	}
+	for _, p := range found {
+		res.Paths = append(res.Paths, toGraphPath(p, vertices, edges))
+	}
+	return GetPaths200JSONResponse(res), nil
+}
@@ -0,0 +1,134 @@
+package api
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// testEdges builds edges from "src>tgt" pairs.
+func testEdges(pairs ...string) []Edge {
+	edges := []Edge{}
+	for _, p := range pairs {
+		src, tgt, _ := strings.Cut(p, ">")
+		edges = append(edges, Edge{Key: edgeKey(src, tgt), Source: src, Target: tgt})
+	}
+	return edges
+}
+
+func testFinder(blocked []string, pairs ...string) pathFinder {
+	edges := testEdges(pairs...)
+	f := pathFinder{
+		next:    adjacency(edges, false),
+		prev:    adjacency(edges, true),
+		blocked: map[string]struct{}{},
+	}
+	for _, k := range blocked {
+		f.blocked[k] = struct{}{}
+	}
+	return f
+}
+
+// two paths of two hops, one of three and one of four
+var pathFixture = []string{
+	"a>b", "b>d",
+	"a>c", "c>d",
+	"a>e", "e>f", "f>d",
+	"a>g", "g>h", "h>i", "i>d",
+}
+
+func TestShortest(t *testing.T) {
+	cases := []struct {
+		name      string
+		blocked   []string
+		src, dst  string
+		skipVerts []string
+		skipEdges []string
+		want      []string
+	}{
+		{name: "first by key", src: "a", dst: "d", want: []string{"a", "b", "d"}},
+		{name: "blocked vertex", blocked: []string{"b", "c"}, src: "a", dst: "d", want: []string{"a", "e", "f", "d"}},
+		{name: "blocked endpoint is usable", blocked: []string{"d"}, src: "a", dst: "d", want: []string{"a", "b", "d"}},
+		{name: "skipped edge", src: "a", dst: "d", skipEdges: []string{"a->b"}, want: []string{"a", "c", "d"}},
+		{name: "skipped vertex", src: "a", dst: "d", skipVerts: []string{"b", "c", "e"}, want: []string{"a", "g", "h", "i", "d"}},
+		{name: "against the dependencies", src: "d", dst: "a", want: nil},
+		{name: "to itself", src: "a", dst: "a", want: []string{"a"}},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			set := func(keys []string) map[string]struct{} {
+				s := map[string]struct{}{}
+				for _, k := range keys {
+					s[k] = struct{}{}
+				}
+				return s
+			}
+			got := testFinder(c.blocked, pathFixture...).shortest(c.src, c.dst, set(c.skipVerts), set(c.skipEdges))
+			if !reflect.DeepEqual(got, c.want) {
+				t.Errorf("got %v, want %v", got, c.want)
+			}
+		})
+	}
+}
+
+func TestAllShortest(t *testing.T) {
+	cases := []struct {
+		name    string
+		blocked []string
+		limit   int
+		want    [][]string
+	}{
+		{name: "every path of two hops", limit: 100, want: [][]string{{"a", "b", "d"}, {"a", "c", "d"}}},
+		{name: "capped", limit: 1, want: [][]string{{"a", "b", "d"}}},
+		{name: "blocked", blocked: []string{"b"}, limit: 100, want: [][]string{{"a", "c", "d"}}},
+		{name: "longer once the short ones are blocked", blocked: []string{"b", "c"}, limit: 100, want: [][]string{{"a", "e", "f", "d"}}},
+		{name: "no path", blocked: []string{"b", "c", "e", "g"}, limit: 100, want: [][]string{}},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := testFinder(c.blocked, pathFixture...).allShortest("a", "d", c.limit)
+			if !reflect.DeepEqual(got, c.want) {
+				t.Errorf("got %v, want %v", got, c.want)
+			}
+		})
+	}
+}
+
+func TestKShortest(t *testing.T) {
+	all := [][]string{
+		{"a", "b", "d"},
+		{"a", "c", "d"},
+		{"a", "e", "f", "d"},
+		{"a", "g", "h", "i", "d"},
+	}
+	cases := []struct {
+		name    string
+		blocked []string
+		k       int
+		want    [][]string
+	}{
+		{name: "one", k: 1, want: all[:1]},
+		{name: "three", k: 3, want: all[:3]},
+		{name: "more than there are", k: 10, want: all},
+		{name: "blocked", blocked: []string{"c", "f"}, k: 10, want: [][]string{all[0], all[3]}},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := testFinder(c.blocked, pathFixture...).kShortest("a", "d", c.k)
+			if !reflect.DeepEqual(got, c.want) {
+				t.Errorf("got %v, want %v", got, c.want)
+			}
+		})
+	}
+}
+
+func TestKShortestSharedPrefix(t *testing.T) {
+	// the spur paths of Yen's algorithm branch off in the middle of the
+	// previous path, not only at the source
+	f := testFinder(nil, "a>b", "b>c", "c>z", "b>x", "x>z", "a>y", "y>w", "w>v", "v>z")
+	got := f.kShortest("a", "z", 3)
+	want := [][]string{{"a", "b", "c", "z"}, {"a", "b", "x", "z"}, {"a", "y", "w", "v", "z"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,606 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/opsminded/graphlib/v2"
+)
+
+var (
+	defaultPropagation      = PropagationPolicy{Mode: Any}
+	defaultGroupPropagation = PropagationPolicy{Mode: All}
+)
+
+// propagationStore keeps the policies that decide how the failures of
+// dependencies propagate to their dependents.
+type propagationStore struct {
+	mu       sync.RWMutex
+	vertices map[string]PropagationPolicy
+	classes  map[string]PropagationPolicy
+	groups   map[string]RedundancyGroup
+	// memberOf indexes the group of each member
+	memberOf map[string]string
+}
+
+func newPropagationStore() *propagationStore {
+	return &propagationStore{
+		vertices: make(map[string]PropagationPolicy),
+		classes:  make(map[string]PropagationPolicy),
+		groups:   make(map[string]RedundancyGroup),
+		memberOf: make(map[string]string),
+	}
+}
+
+// validatePolicy checks p and drops k when the mode does not use it.
+func validatePolicy(p PropagationPolicy) (PropagationPolicy, error) {
+	switch p.Mode {
+	case Any, All, Ignore:
+		p.K = nil
+	case KOfN:
+		if p.K == nil || *p.K < 1 {
+			return p, errors.New("mode k_of_n needs k of at least 1")
+		}
+	default:
+		return p, fmt.Errorf("unknown propagation mode %q", p.Mode)
+	}
+	return p, nil
+}
+
+// fails tells whether policy turns the health of the parts into a failure.
+func fails(policy PropagationPolicy, healthy []bool) bool {
+	n, up := len(healthy), 0
+	for _, h := range healthy {
+		if h {
+			up++
+		}
+	}
+
+	switch policy.Mode {
+	case Ignore:
+		return false
+	case All:
+		return n > 0 && up == 0
+	case KOfN:
+		return up < min(*policy.K, n)
+	default:
+		return up < n
+	}
+}
+
+func (p *propagationStore) resolve(key, class string) ResolvedPropagation {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	r := ResolvedPropagation{Key: key, Policy: defaultPropagation, Source: ResolvedPropagationSourceDefault}
+	if policy, ok := p.vertices[key]; ok {
+		r.Policy, r.Source = policy, ResolvedPropagationSourceVertex
+	} else if policy, ok := p.classes[class]; ok {
+		r.Policy, r.Source = policy, ResolvedPropagationSourceClass
+	}
+	if g, ok := p.memberOf[key]; ok {
+		r.Group = &g
+	}
+	return r
+}
+
+// effective returns the health of every vertex of own once the policies
+// are applied. own is the health of each vertex by itself and deps its
+// dependencies. The dependencies in the same redundancy group count as one
+// part, healthy as the group policy says; the policy of the vertex then
+// decides over its parts.
+func (p *propagationStore) effective(own map[string]bool, deps map[string][]string, classes map[string]string) map[string]bool {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	eff := make(map[string]bool, len(own))
+	var eval func(k string) bool
+	eval = func(k string) bool {
+		if h, ok := eff[k]; ok {
+			return h
+		}
+		eff[k] = own[k]
+		if !own[k] {
+			return false
+		}
+
+		parts := map[string][]bool{}
+		order := []string{}
+		for _, d := range deps[k] {
+			part := "\x00" + d
+			if g, ok := p.memberOf[d]; ok {
+				part = g
+			}
+			if _, seen := parts[part]; !seen {
+				order = append(order, part)
+			}
+			parts[part] = append(parts[part], eval(d))
+		}
+
+		healthy := make([]bool, 0, len(order))
+		for _, part := range order {
+			policy := defaultPropagation
+			if g, ok := p.groups[part]; ok {
+				policy = g.Policy
+			}
+			healthy = append(healthy, !fails(policy, parts[part]))
+		}
+
+		policy := defaultPropagation
+		if c, ok := p.classes[classes[k]]; ok {
+			policy = c
+		}
+		if v, ok := p.vertices[k]; ok {
+			policy = v
+		}
+		eff[k] = !fails(policy, healthy)
+		return eff[k]
+	}
+
+	for k := range own {
+		eval(k)
+	}
+	return eff
+}
+
+// inheritedSources returns, for every vertex that is unhealthy only because
+// of its dependencies, the nearest dependency that fails on its own. seeds
+// are failures left over from a propagation whose source already recovered;
+// they keep their source and pass it on. It walks the dependents of all the
+// failures at once, so the whole graph is visited a single time.
+func inheritedSources(deps map[string][]string, own, eff map[string]bool, seeds map[string]string) map[string]string {
+	dependents := map[string][]string{}
+	roots := []string{}
+	for k, ds := range deps {
+		for _, d := range ds {
+			dependents[d] = append(dependents[d], k)
+		}
+	}
+	for k := range eff {
+		if _, seed := seeds[k]; !seed && !eff[k] && !own[k] {
+			roots = append(roots, k)
+		}
+	}
+	sort.Strings(roots)
+	for k := range dependents {
+		sort.Strings(dependents[k])
+	}
+
+	from := map[string]string{}
+	queue := make([]string, 0, len(roots))
+	for _, k := range roots {
+		from[k] = k
+		queue = append(queue, k)
+	}
+	seeded := make([]string, 0, len(seeds))
+	for k := range seeds {
+		seeded = append(seeded, k)
+	}
+	sort.Strings(seeded)
+	for _, k := range seeded {
+		from[k] = seeds[k]
+		queue = append(queue, k)
+	}
+	for len(queue) > 0 {
+		k := queue[0]
+		queue = queue[1:]
+		for _, d := range dependents[k] {
+			if _, seen := from[d]; seen || eff[d] {
+				continue
+			}
+			from[d] = from[k]
+			queue = append(queue, d)
+		}
+	}
+	for _, k := range roots {
+		delete(from, k)
+	}
+	return from
+}
+
+// healthView is the health of every vertex once the propagation policies
+// are applied. It is worked out over the whole graph once per request.
+type healthView struct {
+	own     map[string]bool
+	deps    map[string][]string
+	classes map[string]string
+	healthy map[string]bool
+	// from is the dependency an inherited failure comes from
+	from map[string]string
+	// seeds are the failures left over from a propagation, by source
+	seeds map[string]string
+	// propagated is what announcing the view remembers: the graph failures
+	// that are not the vertex's own, by source, or "" when the policies
+	// keep the vertex healthy
+	propagated map[string]string
+}
+
+// of returns the health of v in the view and, if its failure is inherited,
+// where it comes from. Vertices the view does not know keep their graph
+// health.
+func (view healthView) of(v graphlib.Vertex) (bool, string) {
+	healthy, ok := view.healthy[v.Key]
+	if !ok {
+		return v.Healthy, ""
+	}
+	return healthy, view.from[v.Key]
+}
+
+func (api *API) healthView() healthView {
+	api.mu.RLock()
+	defer api.mu.RUnlock()
+
+	return api.healthViewLocked()
+}
+
+// healthViewLocked builds the health view of the graph being served.
+// Callers must hold api.mu.
+//
+// The graph does not tell an inherited failure from an own one, so a vertex
+// counts as failing on its own when it was reported unhealthy, or when no
+// dependency is unhealthy, as for the vertices the health check loop found
+// stale. The exception are the failures the loop propagated from a
+// dependency that has recovered since: graphlib keeps them until the vertex
+// is reported, so while its own reports are not stale the vertex keeps the
+// source it was last announced with. The policies only relax failures: a
+// vertex the graph holds healthy stays healthy until the loop propagates to
+// it.
+func (api *API) healthViewLocked() healthView {
+	view := healthView{
+		own:        make(map[string]bool, len(api.catalog.vertices)),
+		deps:       map[string][]string{},
+		classes:    make(map[string]string, len(api.catalog.vertices)),
+		healthy:    make(map[string]bool, len(api.catalog.vertices)),
+		seeds:      map[string]string{},
+		propagated: map[string]string{},
+	}
+
+	graphHealth := make(map[string]bool, len(api.catalog.vertices))
+	for k, v := range api.catalog.vertices {
+		graphHealth[k] = true
+		view.classes[k] = v.Class
+	}
+	for _, v := range api.graph.Stats().UnhealthyVertices {
+		if _, ok := graphHealth[v.Key]; ok {
+			graphHealth[v.Key] = false
+		}
+	}
+	for _, e := range api.catalog.edges {
+		view.deps[e.Source] = append(view.deps[e.Source], e.Target)
+	}
+	for k := range view.deps {
+		sort.Strings(view.deps[k])
+	}
+
+	reported := api.history.failing()
+	propagated := api.history.propagation()
+	// without the loop nothing goes stale
+	stale := func(string) bool { return false }
+	if api.loop != nil {
+		since := time.Now().Add(-api.loop.interval)
+		stale = func(k string) bool { return !api.history.checkedSince(k, since) }
+	}
+	for k, healthy := range graphHealth {
+		if _, ok := reported[k]; ok {
+			view.own[k] = false
+			continue
+		}
+		view.own[k] = healthy
+		for _, d := range view.deps[k] {
+			if !graphHealth[d] {
+				view.own[k] = true
+				break
+			}
+		}
+		if from, ok := propagated[k]; ok && !view.own[k] && !stale(k) {
+			if _, exists := api.catalog.vertices[from]; from != "" && !exists {
+				continue
+			}
+			if from == "" {
+				view.own[k] = true
+			} else {
+				view.seeds[k] = from
+			}
+		}
+	}
+
+	eff := api.propagation.effective(view.own, view.deps, view.classes)
+	for k, healthy := range graphHealth {
+		view.healthy[k] = healthy || eff[k]
+	}
+	view.from = inheritedSources(view.deps, view.own, view.healthy, view.seeds)
+	for k, healthy := range graphHealth {
+		if _, seed := view.seeds[k]; !healthy && (seed || view.own[k]) {
+			view.propagated[k] = view.from[k]
+		}
+	}
+	return view
+}
+
+func (p *propagationStore) setVertex(key string, policy PropagationPolicy) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.vertices[key] = policy
+}
+
+func (p *propagationStore) removeVertexPolicy(key string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	delete(p.vertices, key)
+}
+
+// remove forgets a deleted vertex, dropping groups left without members.
+func (p *propagationStore) remove(key string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	delete(p.vertices, key)
+	name, ok := p.memberOf[key]
+	if !ok {
+		return
+	}
+	delete(p.memberOf, key)
+
+	g := p.groups[name]
+	members := []string{}
+	for _, m := range g.Members {
+		if m != key {
+			members = append(members, m)
+		}
+	}
+	if len(members) == 0 {
+		delete(p.groups, name)
+		return
+	}
+	g.Members = members
+	p.groups[name] = g
+}
+
+func (p *propagationStore) classList() []ClassPropagation {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	list := []ClassPropagation{}
+	for c, policy := range p.classes {
+		list = append(list, ClassPropagation{Class: c, Policy: policy})
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Class < list[j].Class })
+	return list
+}
+
+func (p *propagationStore) setClass(class string, policy PropagationPolicy) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.classes[class] = policy
+}
+
+func (p *propagationStore) removeClass(class string) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	_, ok := p.classes[class]
+	delete(p.classes, class)
+	return ok
+}
+
+func (p *propagationStore) groupList() []RedundancyGroup {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	list := []RedundancyGroup{}
+	for _, g := range p.groups {
+		list = append(list, copyGroup(g))
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Name < list[j].Name })
+	return list
+}
+
+func (p *propagationStore) group(name string) (RedundancyGroup, bool) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	g, ok := p.groups[name]
+	return copyGroup(g), ok
+}
+
+// setGroup creates or replaces a group. A vertex belongs to one group at
+// most.
+func (p *propagationStore) setGroup(g RedundancyGroup) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for _, m := range g.Members {
+		if other, ok := p.memberOf[m]; ok && other != g.Name {
+			return fmt.Errorf("vertex %q already belongs to group %q", m, other)
+		}
+	}
+
+	p.dropGroup(g.Name)
+	p.groups[g.Name] = copyGroup(g)
+	for _, m := range g.Members {
+		p.memberOf[m] = g.Name
+	}
+	return nil
+}
+
+func (p *propagationStore) removeGroup(name string) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	_, ok := p.groups[name]
+	p.dropGroup(name)
+	return ok
+}
+
+func (p *propagationStore) dropGroup(name string) {
+	for _, m := range p.groups[name].Members {
+		delete(p.memberOf, m)
+	}
+	delete(p.groups, name)
+}
+
+func copyGroup(g RedundancyGroup) RedundancyGroup {
+	g.Members = append([]string{}, g.Members...)
+	return g
+}
+
+func (api *API) GetVertexPropagation(ctx context.Context, request GetVertexPropagationRequestObject) (GetVertexPropagationResponseObject, error) {
+	v, err := api.svc().GetVertex(request.Key)
+	if errors.As(err, &graphlib.VertexNotFoundErr{}) {
+		nf := NotFoundJSONResponse{Code: 404, Error: err.Error()}
+		return GetVertexPropagation404JSONResponse{NotFoundJSONResponse: nf}, nil
+	}
+	if err != nil {
+		ise := InternalServerErrorJSONResponse{Code: 500, Error: err.Error()}
+		return GetVertexPropagation500JSONResponse{InternalServerErrorJSONResponse: ise}, nil
+	}
+	return GetVertexPropagation200JSONResponse(api.propagation.resolve(v.Key, v.Class)), nil
+}
+
+func (api *API) SetVertexPropagation(ctx context.Context, request SetVertexPropagationRequestObject) (SetVertexPropagationResponseObject, error) {
+	if request.Body == nil {
+		ir := InvalidRequestJSONResponse{Code: 422, Error: "request body is required"}
+		return SetVertexPropagation422JSONResponse{InvalidRequestJSONResponse: ir}, nil
+	}
+	policy, err := validatePolicy(*request.Body)
+	if err != nil {
+		ir := InvalidRequestJSONResponse{Code: 422, Error: err.Error()}
+		return SetVertexPropagation422JSONResponse{InvalidRequestJSONResponse: ir}, nil
+	}
+
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	rec, ok := api.catalog.vertices[request.Key]
+	if !ok {
+		nf := NotFoundJSONResponse{Code: 404, Error: graphlib.VertexNotFoundErr{Key: request.Key}.Error()}
+		return SetVertexPropagation404JSONResponse{NotFoundJSONResponse: nf}, nil
+	}
+	api.propagation.setVertex(rec.Key, policy)
+	api.announceHealthLocked()
+	return SetVertexPropagation200JSONResponse(api.propagation.resolve(rec.Key, rec.Class)), nil
+}
+
+func (api *API) DeleteVertexPropagation(ctx context.Context, request DeleteVertexPropagationRequestObject) (DeleteVertexPropagationResponseObject, error) {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	rec, ok := api.catalog.vertices[request.Key]
+	if !ok {
+		nf := NotFoundJSONResponse{Code: 404, Error: graphlib.VertexNotFoundErr{Key: request.Key}.Error()}
+		return DeleteVertexPropagation404JSONResponse{NotFoundJSONResponse: nf}, nil
+	}
+	api.propagation.removeVertexPolicy(rec.Key)
+	api.announceHealthLocked()
+	return DeleteVertexPropagation200JSONResponse(api.propagation.resolve(rec.Key, rec.Class)), nil
+}
+
+func (api *API) ListClassPropagation(ctx context.Context, request ListClassPropagationRequestObject) (ListClassPropagationResponseObject, error) {
+	return ListClassPropagation200JSONResponse(api.propagation.classList()), nil
+}
+
+func (api *API) SetClassPropagation(ctx context.Context, request SetClassPropagationRequestObject) (SetClassPropagationResponseObject, error) {
+	if request.Body == nil {
+		ir := InvalidRequestJSONResponse{Code: 422, Error: "request body is required"}
+		return SetClassPropagation422JSONResponse{InvalidRequestJSONResponse: ir}, nil
+	}
+	policy, err := validatePolicy(*request.Body)
+	if err != nil {
+		ir := InvalidRequestJSONResponse{Code: 422, Error: err.Error()}
+		return SetClassPropagation422JSONResponse{InvalidRequestJSONResponse: ir}, nil
+	}
+
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	api.propagation.setClass(request.Class, policy)
+	api.announceHealthLocked()
+	return SetClassPropagation200JSONResponse(ClassPropagation{Class: request.Class, Policy: policy}), nil
+}
+
+func (api *API) DeleteClassPropagation(ctx context.Context, request DeleteClassPropagationRequestObject) (DeleteClassPropagationResponseObject, error) {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	if !api.propagation.removeClass(request.Class) {
+		nf := NotFoundJSONResponse{Code: 404, Error: fmt.Sprintf("class %q has no propagation policy", request.Class)}
+		return DeleteClassPropagation404JSONResponse{NotFoundJSONResponse: nf}, nil
+	}
+	api.announceHealthLocked()
+	return DeleteClassPropagation200Response{}, nil
+}
+
+func (api *API) ListRedundancyGroups(ctx context.Context, request ListRedundancyGroupsRequestObject) (ListRedundancyGroupsResponseObject, error) {
+	return ListRedundancyGroups200JSONResponse(api.propagation.groupList()), nil
+}
+
+func (api *API) GetRedundancyGroup(ctx context.Context, request GetRedundancyGroupRequestObject) (GetRedundancyGroupResponseObject, error) {
+	g, ok := api.propagation.group(request.Name)
+	if !ok {
+		nf := NotFoundJSONResponse{Code: 404, Error: fmt.Sprintf("redundancy group %q not found", request.Name)}
+		return GetRedundancyGroup404JSONResponse{NotFoundJSONResponse: nf}, nil
+	}
+	return GetRedundancyGroup200JSONResponse(g), nil
+}
+
+func (api *API) SetRedundancyGroup(ctx context.Context, request SetRedundancyGroupRequestObject) (SetRedundancyGroupResponseObject, error) {
+	invalid := func(msg string) (SetRedundancyGroupResponseObject, error) {
+		ir := InvalidRequestJSONResponse{Code: 422, Error: msg}
+		return SetRedundancyGroup422JSONResponse{InvalidRequestJSONResponse: ir}, nil
+	}
+	if request.Body == nil {
+		return invalid("request body is required")
+	}
+	if len(request.Body.Members) == 0 {
+		return invalid("a redundancy group needs at least one member")
+	}
+
+	g := RedundancyGroup{Name: request.Name, Members: []string{}, Policy: defaultGroupPropagation}
+	if request.Body.Policy != nil {
+		policy, err := validatePolicy(*request.Body.Policy)
+		if err != nil {
+			return invalid(err.Error())
+		}
+		g.Policy = policy
+	}
+
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	seen := map[string]struct{}{}
+	for _, m := range request.Body.Members {
+		if _, ok := api.catalog.vertices[m]; !ok {
+			return invalid(fmt.Sprintf("redundancy group references unknown vertex %q", m))
+		}
+		if _, dup := seen[m]; dup {
+			return invalid(fmt.Sprintf("vertex %q is listed twice", m))
+		}
+		seen[m] = struct{}{}
+		g.Members = append(g.Members, m)
+	}
+
+	if err := api.propagation.setGroup(g); err != nil {
+		return invalid(err.Error())
+	}
+	api.announceHealthLocked()
+	return SetRedundancyGroup200JSONResponse(g), nil
+}
+
+func (api *API) DeleteRedundancyGroup(ctx context.Context, request DeleteRedundancyGroupRequestObject) (DeleteRedundancyGroupResponseObject, error) {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	if !api.propagation.removeGroup(request.Name) {
+		nf := NotFoundJSONResponse{Code: 404, Error: fmt.Sprintf("redundancy group %q not found", request.Name)}
+		return DeleteRedundancyGroup404JSONResponse{NotFoundJSONResponse: nf}, nil
+	}
+	api.announceHealthLocked()
+	return DeleteRedundancyGroup200Response{}, nil
+}
@@ -0,0 +1,615 @@
+package api
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+)
+
+// propagated returns an API serving app>web>db where db was reported
+// unhealthy and the loop spread the failure to web and app.
+func propagated(t *testing.T) *API {
+	t.Helper()
+	api := testAPI(t, "web>db", "app>web")
+	if _, err := api.MarkVertexUnhealthy(context.Background(), MarkVertexUnhealthyRequestObject{Key: "db"}); err != nil {
+		t.Fatal(err)
+	}
+	// what the loop does on its next tick
+	for _, k := range []string{"web", "app"} {
+		if err := api.graph.SetVertexHealth(k, false); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return api
+}
+
+type origin struct {
+	healthy bool
+	origin  HealthOrigin
+	from    string
+}
+
+func origins(t *testing.T, api *API, keys ...string) map[string]origin {
+	t.Helper()
+	got := map[string]origin{}
+	for _, k := range keys {
+		res, err := api.GetVertex(context.Background(), GetVertexRequestObject{Key: k})
+		if err != nil {
+			t.Fatal(err)
+		}
+		v := res.(GetVertex200JSONResponse)
+		o := origin{healthy: v.Healthy, origin: v.HealthOrigin}
+		if v.InheritedFrom != nil {
+			o.from = *v.InheritedFrom
+		}
+		got[k] = o
+	}
+	return got
+}
+
+func TestRecoveryKeepsInheritedSource(t *testing.T) {
+	api := propagated(t)
+	ctx := context.Background()
+	tick(api)
+
+	want := map[string]origin{
+		"db":  {healthy: false, origin: Direct},
+		"web": {healthy: false, origin: Inherited, from: "db"},
+		"app": {healthy: false, origin: Inherited, from: "db"},
+	}
+	if got := origins(t, api, "db", "web", "app"); !equalOrigins(got, want) {
+		t.Fatalf("got %v before the recovery", got)
+	}
+
+	taken := collect(t, api)
+	if _, err := api.MarkVertexHealthy(ctx, MarkVertexHealthyRequestObject{Key: "db"}); err != nil {
+		t.Fatal(err)
+	}
+	// graphlib keeps the propagated failures until web and app report
+	want["db"] = origin{healthy: true, origin: Direct}
+	if got := origins(t, api, "db", "web", "app"); !equalOrigins(got, want) {
+		t.Errorf("got %v after the recovery", got)
+	}
+	if got := healthEvents(taken()); len(got) != 1 || !got["db"] {
+		t.Errorf("got events %v", got)
+	}
+	s := summarize(t, api, SummaryParams{})
+	if s.DirectUnhealthy != 0 || s.InheritedUnhealthy != 2 {
+		t.Errorf("got %d direct and %d inherited", s.DirectUnhealthy, s.InheritedUnhealthy)
+	}
+
+	// once web reports, app still holds the failure that came from db
+	if _, err := api.MarkVertexHealthy(ctx, MarkVertexHealthyRequestObject{Key: "web"}); err != nil {
+		t.Fatal(err)
+	}
+	want["web"] = origin{healthy: true, origin: Direct}
+	if got := origins(t, api, "db", "web", "app"); !equalOrigins(got, want) {
+		t.Errorf("got %v after web reported", got)
+	}
+
+	// a simulation starts from the same labels
+	r := simulate(t, api, SimulationRequest{})
+	if len(r.Changes) != 0 {
+		t.Errorf("got changes %+v", r.Changes)
+	}
+	r = simulate(t, api, SimulationRequest{Recover: &[]string{"app"}})
+	if got := changed(r); len(got) != 1 || !got["app"] {
+		t.Errorf("got changes %v", got)
+	}
+}
+
+func TestRecoveryStaleHeartbeat(t *testing.T) {
+	cases := []struct {
+		name    string
+		checked time.Duration
+		want    map[string]origin
+	}{
+		{
+			// web stopped reporting, so the loop would fail it by itself
+			name:    "stale",
+			checked: -time.Hour,
+			want: map[string]origin{
+				"web": {healthy: false, origin: Direct},
+				"app": {healthy: false, origin: Inherited, from: "web"},
+			},
+		},
+		{
+			name:    "recent",
+			checked: -time.Second,
+			want: map[string]origin{
+				"web": {healthy: false, origin: Inherited, from: "db"},
+				"app": {healthy: false, origin: Inherited, from: "db"},
+			},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			api := propagated(t)
+			api.loop = &healthLoop{interval: time.Minute}
+			api.history.checked["web"] = time.Now().Add(c.checked)
+			api.history.checked["app"] = time.Now()
+			tick(api)
+
+			if _, err := api.MarkVertexHealthy(context.Background(), MarkVertexHealthyRequestObject{Key: "db"}); err != nil {
+				t.Fatal(err)
+			}
+			if got := origins(t, api, "web", "app"); !equalOrigins(got, c.want) {
+				t.Errorf("got %v", got)
+			}
+		})
+	}
+}
+
+// the watch has not looked since the loop propagated
+func TestRecoveryBeforeTheWatch(t *testing.T) {
+	api := propagated(t)
+	taken := collect(t, api)
+
+	if _, err := api.MarkVertexHealthy(context.Background(), MarkVertexHealthyRequestObject{Key: "db"}); err != nil {
+		t.Fatal(err)
+	}
+	if got := healthEvents(taken()); len(got) != 3 || !got["db"] || got["web"] || got["app"] {
+		t.Errorf("got events %v", got)
+	}
+	if got := origins(t, api, "web"); got["web"].from != "db" {
+		t.Errorf("got %v", got)
+	}
+}
+
+func TestRecoveryUnderPolicy(t *testing.T) {
+	api := propagated(t)
+	ctx := context.Background()
+	if _, err := api.SetVertexPropagation(ctx, SetVertexPropagationRequestObject{Key: "web", Body: &PropagationPolicy{Mode: Ignore}}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := api.MarkVertexHealthy(ctx, MarkVertexHealthyRequestObject{Key: "db"}); err != nil {
+		t.Fatal(err)
+	}
+
+	// web ignored the failure of db and does not fail now it is gone
+	want := map[string]origin{
+		"web": {healthy: true, origin: Direct},
+		"app": {healthy: true, origin: Direct},
+	}
+	if got := origins(t, api, "web", "app"); !equalOrigins(got, want) {
+		t.Errorf("got %v", got)
+	}
+}
+
+func TestRecoveryRemovedSource(t *testing.T) {
+	api := propagated(t)
+	tick(api)
+
+	if _, err := api.DeleteVertex(context.Background(), DeleteVertexRequestObject{Key: "db"}); err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]origin{
+		"web": {healthy: false, origin: Direct},
+		"app": {healthy: false, origin: Inherited, from: "web"},
+	}
+	if got := origins(t, api, "web", "app"); !equalOrigins(got, want) {
+		t.Errorf("got %v", got)
+	}
+}
+
+// tick announces the health as the watch does after each loop tick.
+func tick(api *API) {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+	api.announceHealthLocked()
+}
+
+func equalOrigins(got, want map[string]origin) bool {
+	if len(got) != len(want) {
+		return false
+	}
+	for k, w := range want {
+		if got[k] != w {
+			return false
+		}
+	}
+	return true
+}
+
+func k(n int) *int { return &n }
+
+func TestFails(t *testing.T) {
+	cases := []struct {
+		name    string
+		policy  PropagationPolicy
+		healthy []bool
+		want    bool
+	}{
+		{name: "any, all up", policy: PropagationPolicy{Mode: Any}, healthy: []bool{true, true}},
+		{name: "any, one down", policy: PropagationPolicy{Mode: Any}, healthy: []bool{true, false}, want: true},
+		{name: "any, no parts", policy: PropagationPolicy{Mode: Any}},
+		{name: "all, one up", policy: PropagationPolicy{Mode: All}, healthy: []bool{false, true}},
+		{name: "all, all down", policy: PropagationPolicy{Mode: All}, healthy: []bool{false, false}, want: true},
+		{name: "all, no parts", policy: PropagationPolicy{Mode: All}},
+		{name: "k_of_n, quorum", policy: PropagationPolicy{Mode: KOfN, K: k(2)}, healthy: []bool{true, false, true}},
+		{name: "k_of_n, below quorum", policy: PropagationPolicy{Mode: KOfN, K: k(2)}, healthy: []bool{true, false, false}, want: true},
+		{name: "k_of_n, k above n", policy: PropagationPolicy{Mode: KOfN, K: k(5)}, healthy: []bool{true, true}},
+		{name: "k_of_n, k above n, one down", policy: PropagationPolicy{Mode: KOfN, K: k(5)}, healthy: []bool{true, false}, want: true},
+		{name: "ignore, all down", policy: PropagationPolicy{Mode: Ignore}, healthy: []bool{false, false}},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := fails(c.policy, c.healthy); got != c.want {
+				t.Errorf("got %v, want %v", got, c.want)
+			}
+		})
+	}
+}
+
+func TestValidatePolicy(t *testing.T) {
+	cases := []struct {
+		name   string
+		policy PropagationPolicy
+		want   PropagationPolicy
+		err    string
+	}{
+		{name: "any drops k", policy: PropagationPolicy{Mode: Any, K: k(2)}, want: PropagationPolicy{Mode: Any}},
+		{name: "all drops k", policy: PropagationPolicy{Mode: All, K: k(2)}, want: PropagationPolicy{Mode: All}},
+		{name: "ignore drops k", policy: PropagationPolicy{Mode: Ignore, K: k(2)}, want: PropagationPolicy{Mode: Ignore}},
+		{name: "k_of_n keeps k", policy: PropagationPolicy{Mode: KOfN, K: k(2)}, want: PropagationPolicy{Mode: KOfN, K: k(2)}},
+		{name: "k_of_n without k", policy: PropagationPolicy{Mode: KOfN}, err: "mode k_of_n needs k of at least 1"},
+		{name: "k_of_n with k zero", policy: PropagationPolicy{Mode: KOfN, K: k(0)}, err: "mode k_of_n needs k of at least 1"},
+		{name: "unknown mode", policy: PropagationPolicy{Mode: "most"}, err: `unknown propagation mode "most"`},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got, err := validatePolicy(c.policy)
+			if c.err != "" {
+				if err == nil || err.Error() != c.err {
+					t.Fatalf("got error %v, want %q", err, c.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, c.want) {
+				t.Errorf("got %+v, want %+v", got, c.want)
+			}
+		})
+	}
+}
+
+func TestEffective(t *testing.T) {
+	all := PropagationPolicy{Mode: All}
+	strict := PropagationPolicy{Mode: Any}
+	quorum := PropagationPolicy{Mode: KOfN, K: k(2)}
+	ignore := PropagationPolicy{Mode: Ignore}
+
+	cases := []struct {
+		name     string
+		pairs    []string
+		down     []string
+		classes  map[string]string
+		vertices map[string]PropagationPolicy
+		policies map[string]PropagationPolicy
+		groups   []RedundancyGroup
+		want     []string
+	}{
+		{name: "any by default", pairs: []string{"web>db1", "web>db2"}, down: []string{"db1"}, want: []string{"db1", "web"}},
+		{name: "all, one dependency up", pairs: []string{"web>db1", "web>db2"}, down: []string{"db1"}, vertices: map[string]PropagationPolicy{"web": all}, want: []string{"db1"}},
+		{name: "all, every dependency down", pairs: []string{"web>db1", "web>db2"}, down: []string{"db1", "db2"}, vertices: map[string]PropagationPolicy{"web": all}, want: []string{"db1", "db2", "web"}},
+		{name: "k_of_n, quorum", pairs: []string{"web>db1", "web>db2", "web>db3"}, down: []string{"db1"}, vertices: map[string]PropagationPolicy{"web": quorum}, want: []string{"db1"}},
+		{name: "k_of_n, below quorum", pairs: []string{"web>db1", "web>db2", "web>db3"}, down: []string{"db1", "db3"}, vertices: map[string]PropagationPolicy{"web": quorum}, want: []string{"db1", "db3", "web"}},
+		{name: "ignore", pairs: []string{"web>db1"}, down: []string{"db1"}, vertices: map[string]PropagationPolicy{"web": ignore}, want: []string{"db1"}},
+		{name: "ignore keeps its own failure", pairs: []string{"web>db1"}, down: []string{"web"}, vertices: map[string]PropagationPolicy{"web": ignore}, want: []string{"web"}},
+		{name: "transitive", pairs: []string{"app>web", "web>db1"}, down: []string{"db1"}, want: []string{"app", "db1", "web"}},
+		{name: "a tolerant middle stops the failure", pairs: []string{"app>web", "web>db1", "web>db2"}, down: []string{"db1"}, vertices: map[string]PropagationPolicy{"web": all}, want: []string{"db1"}},
+		{
+			name: "class policy", pairs: []string{"web>db1", "web>db2"}, down: []string{"db1"},
+			classes: map[string]string{"web": "frontend"}, policies: map[string]PropagationPolicy{"frontend": all},
+			want: []string{"db1"},
+		},
+		{
+			name: "class policy of another class", pairs: []string{"web>db1", "web>db2"}, down: []string{"db1"},
+			classes: map[string]string{"web": "frontend"}, policies: map[string]PropagationPolicy{"database": all},
+			want: []string{"db1", "web"},
+		},
+		{
+			name: "vertex policy over class policy", pairs: []string{"web>db1", "web>db2"}, down: []string{"db1"},
+			classes: map[string]string{"web": "frontend"}, policies: map[string]PropagationPolicy{"frontend": all},
+			vertices: map[string]PropagationPolicy{"web": strict},
+			want:     []string{"db1", "web"},
+		},
+		{
+			name: "group counts as one part", pairs: []string{"web>db1", "web>db2", "web>cache"}, down: []string{"db1"},
+			groups: []RedundancyGroup{{Name: "dbs", Members: []string{"db1", "db2"}, Policy: all}},
+			want:   []string{"db1"},
+		},
+		{
+			name: "group down", pairs: []string{"web>db1", "web>db2", "web>cache"}, down: []string{"db1", "db2"},
+			groups: []RedundancyGroup{{Name: "dbs", Members: []string{"db1", "db2"}, Policy: all}},
+			want:   []string{"db1", "db2", "web"},
+		},
+		{
+			name: "part outside the group", pairs: []string{"web>db1", "web>db2", "web>cache"}, down: []string{"cache"},
+			groups: []RedundancyGroup{{Name: "dbs", Members: []string{"db1", "db2"}, Policy: all}},
+			want:   []string{"cache", "web"},
+		},
+		{
+			name: "group quorum", pairs: []string{"web>db1", "web>db2", "web>db3"}, down: []string{"db1"},
+			groups: []RedundancyGroup{{Name: "dbs", Members: []string{"db1", "db2", "db3"}, Policy: quorum}},
+			want:   []string{"db1"},
+		},
+		{
+			name: "group below quorum", pairs: []string{"web>db1", "web>db2", "web>db3"}, down: []string{"db2", "db3"},
+			groups: []RedundancyGroup{{Name: "dbs", Members: []string{"db1", "db2", "db3"}, Policy: quorum}},
+			want:   []string{"db2", "db3", "web"},
+		},
+		{
+			name: "vertex policy over the group parts", pairs: []string{"web>db1", "web>db2", "web>cache"}, down: []string{"db1", "db2"},
+			vertices: map[string]PropagationPolicy{"web": all},
+			groups:   []RedundancyGroup{{Name: "dbs", Members: []string{"db1", "db2"}, Policy: all}},
+			want:     []string{"db1", "db2"},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			p := newPropagationStore()
+			for key, policy := range c.vertices {
+				p.setVertex(key, policy)
+			}
+			for class, policy := range c.policies {
+				p.setClass(class, policy)
+			}
+			for _, g := range c.groups {
+				if err := p.setGroup(g); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			own := map[string]bool{}
+			deps := map[string][]string{}
+			for _, pair := range c.pairs {
+				src, tgt, _ := strings.Cut(pair, ">")
+				own[src], own[tgt] = true, true
+				deps[src] = append(deps[src], tgt)
+			}
+			for _, key := range c.down {
+				own[key] = false
+			}
+
+			got := []string{}
+			for key, healthy := range p.effective(own, deps, c.classes) {
+				if !healthy {
+					got = append(got, key)
+				}
+			}
+			sort.Strings(got)
+			if !reflect.DeepEqual(got, c.want) {
+				t.Errorf("got %v unhealthy, want %v", got, c.want)
+			}
+		})
+	}
+}
+
+func TestResolve(t *testing.T) {
+	p := newPropagationStore()
+	p.setClass("server", PropagationPolicy{Mode: All})
+	p.setVertex("web", PropagationPolicy{Mode: Ignore})
+	if err := p.setGroup(RedundancyGroup{Name: "dbs", Members: []string{"db"}, Policy: defaultGroupPropagation}); err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		key, class string
+		mode       PropagationMode
+		source     ResolvedPropagationSource
+		group      string
+	}{
+		{key: "web", class: "server", mode: Ignore, source: ResolvedPropagationSourceVertex},
+		{key: "db", class: "server", mode: All, source: ResolvedPropagationSourceClass, group: "dbs"},
+		{key: "cache", class: "cache", mode: Any, source: ResolvedPropagationSourceDefault},
+	}
+	for _, c := range cases {
+		t.Run(c.key, func(t *testing.T) {
+			r := p.resolve(c.key, c.class)
+			group := ""
+			if r.Group != nil {
+				group = *r.Group
+			}
+			if r.Key != c.key || r.Policy.Mode != c.mode || r.Source != c.source || group != c.group {
+				t.Errorf("got %+v (group %q)", r, group)
+			}
+		})
+	}
+}
+
+func TestVertexPropagationHandlers(t *testing.T) {
+	api := propagated(t)
+	ctx := context.Background()
+	tick(api)
+	taken := collect(t, api)
+
+	res, _ := api.GetVertexPropagation(ctx, GetVertexPropagationRequestObject{Key: "gone"})
+	if _, ok := res.(GetVertexPropagation404JSONResponse); !ok {
+		t.Errorf("got %T for an unknown vertex", res)
+	}
+	res, _ = api.GetVertexPropagation(ctx, GetVertexPropagationRequestObject{Key: "web"})
+	if r, ok := res.(GetVertexPropagation200JSONResponse); !ok || r.Policy.Mode != Any || r.Source != ResolvedPropagationSourceDefault {
+		t.Errorf("got %+v by default", res)
+	}
+
+	invalid := []struct {
+		name string
+		body *PropagationPolicy
+		err  string
+	}{
+		{name: "no body", err: "request body is required"},
+		{name: "k_of_n without k", body: &PropagationPolicy{Mode: KOfN}, err: "mode k_of_n needs k of at least 1"},
+		{name: "unknown mode", body: &PropagationPolicy{Mode: "most"}, err: `unknown propagation mode "most"`},
+	}
+	for _, c := range invalid {
+		t.Run(c.name, func(t *testing.T) {
+			res, _ := api.SetVertexPropagation(ctx, SetVertexPropagationRequestObject{Key: "web", Body: c.body})
+			if r, ok := res.(SetVertexPropagation422JSONResponse); !ok || r.Error != c.err {
+				t.Errorf("got %+v, want %q", res, c.err)
+			}
+		})
+	}
+	set, _ := api.SetVertexPropagation(ctx, SetVertexPropagationRequestObject{Key: "gone", Body: &PropagationPolicy{Mode: All}})
+	if _, ok := set.(SetVertexPropagation404JSONResponse); !ok {
+		t.Errorf("got %T for an unknown vertex", set)
+	}
+	if got := taken(); len(got) != 0 {
+		t.Errorf("got %+v from the rejected requests", got)
+	}
+
+	set, _ = api.SetVertexPropagation(ctx, SetVertexPropagationRequestObject{Key: "web", Body: &PropagationPolicy{Mode: Ignore, K: k(2)}})
+	if r, ok := set.(SetVertexPropagation200JSONResponse); !ok || r.Policy.Mode != Ignore || r.Policy.K != nil || r.Source != ResolvedPropagationSourceVertex {
+		t.Errorf("got %+v", set)
+	}
+	if got := healthEvents(taken()); len(got) != 2 || !got["web"] || !got["app"] {
+		t.Errorf("got events %v after ignoring db", got)
+	}
+
+	del, _ := api.DeleteVertexPropagation(ctx, DeleteVertexPropagationRequestObject{Key: "web"})
+	if r, ok := del.(DeleteVertexPropagation200JSONResponse); !ok || r.Policy.Mode != Any || r.Source != ResolvedPropagationSourceDefault {
+		t.Errorf("got %+v", del)
+	}
+	if got := healthEvents(taken()); len(got) != 2 || got["web"] || got["app"] {
+		t.Errorf("got events %v after the delete", got)
+	}
+	del, _ = api.DeleteVertexPropagation(ctx, DeleteVertexPropagationRequestObject{Key: "gone"})
+	if _, ok := del.(DeleteVertexPropagation404JSONResponse); !ok {
+		t.Errorf("got %T for an unknown vertex", del)
+	}
+}
+
+func TestClassPropagationHandlers(t *testing.T) {
+	api := propagated(t)
+	ctx := context.Background()
+	tick(api)
+	taken := collect(t, api)
+
+	res, _ := api.SetClassPropagation(ctx, SetClassPropagationRequestObject{Class: "server"})
+	if r, ok := res.(SetClassPropagation422JSONResponse); !ok || r.Error != "request body is required" {
+		t.Errorf("got %+v without a body", res)
+	}
+	res, _ = api.SetClassPropagation(ctx, SetClassPropagationRequestObject{Class: "server", Body: &PropagationPolicy{Mode: KOfN, K: k(0)}})
+	if r, ok := res.(SetClassPropagation422JSONResponse); !ok || r.Error != "mode k_of_n needs k of at least 1" {
+		t.Errorf("got %+v for a bad policy", res)
+	}
+
+	for _, class := range []string{"server", "database"} {
+		if _, err := api.SetClassPropagation(ctx, SetClassPropagationRequestObject{Class: class, Body: &PropagationPolicy{Mode: Ignore}}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	list, _ := api.ListClassPropagation(ctx, ListClassPropagationRequestObject{})
+	classes := []string{}
+	for _, c := range list.(ListClassPropagation200JSONResponse) {
+		classes = append(classes, c.Class)
+	}
+	if !reflect.DeepEqual(classes, []string{"database", "server"}) {
+		t.Errorf("got %v", classes)
+	}
+	if got := healthEvents(taken()); len(got) != 2 || !got["web"] || !got["app"] {
+		t.Errorf("got events %v after ignoring the class", got)
+	}
+
+	// a vertex policy wins over the class policy
+	if _, err := api.SetVertexPropagation(ctx, SetVertexPropagationRequestObject{Key: "web", Body: &PropagationPolicy{Mode: Any}}); err != nil {
+		t.Fatal(err)
+	}
+	if got := healthEvents(taken()); len(got) != 1 || got["web"] {
+		t.Errorf("got events %v after the vertex policy", got)
+	}
+
+	del, _ := api.DeleteClassPropagation(ctx, DeleteClassPropagationRequestObject{Class: "server"})
+	if _, ok := del.(DeleteClassPropagation200Response); !ok {
+		t.Errorf("got %T", del)
+	}
+	if got := healthEvents(taken()); len(got) != 1 || got["app"] {
+		t.Errorf("got events %v after the delete", got)
+	}
+	del, _ = api.DeleteClassPropagation(ctx, DeleteClassPropagationRequestObject{Class: "server"})
+	if r, ok := del.(DeleteClassPropagation404JSONResponse); !ok || r.Error != `class "server" has no propagation policy` {
+		t.Errorf("got %+v for a class without a policy", del)
+	}
+}
+
+func TestRedundancyGroupHandlers(t *testing.T) {
+	api := testAPI(t, "web>db1", "web>db2", "app>cache")
+	ctx := context.Background()
+	if _, err := api.MarkVertexUnhealthy(ctx, MarkVertexUnhealthyRequestObject{Key: "db1"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := api.graph.SetVertexHealth("web", false); err != nil {
+		t.Fatal(err)
+	}
+	tick(api)
+	if err := api.propagation.setGroup(RedundancyGroup{Name: "caches", Members: []string{"cache"}, Policy: defaultGroupPropagation}); err != nil {
+		t.Fatal(err)
+	}
+	taken := collect(t, api)
+
+	invalid := []struct {
+		name string
+		body *NewRedundancyGroup
+		err  string
+	}{
+		{name: "no body", err: "request body is required"},
+		{name: "no members", body: &NewRedundancyGroup{}, err: "a redundancy group needs at least one member"},
+		{name: "unknown member", body: &NewRedundancyGroup{Members: []string{"db1", "gone"}}, err: `redundancy group references unknown vertex "gone"`},
+		{name: "member twice", body: &NewRedundancyGroup{Members: []string{"db1", "db1"}}, err: `vertex "db1" is listed twice`},
+		{name: "member of another group", body: &NewRedundancyGroup{Members: []string{"db1", "cache"}}, err: `vertex "cache" already belongs to group "caches"`},
+		{name: "bad policy", body: &NewRedundancyGroup{Members: []string{"db1"}, Policy: &PropagationPolicy{Mode: "most"}}, err: `unknown propagation mode "most"`},
+	}
+	for _, c := range invalid {
+		t.Run(c.name, func(t *testing.T) {
+			res, _ := api.SetRedundancyGroup(ctx, SetRedundancyGroupRequestObject{Name: "dbs", Body: c.body})
+			if r, ok := res.(SetRedundancyGroup422JSONResponse); !ok || r.Error != c.err {
+				t.Errorf("got %+v, want %q", res, c.err)
+			}
+		})
+	}
+	if got := taken(); len(got) != 0 {
+		t.Errorf("got %+v from the rejected requests", got)
+	}
+
+	res, _ := api.SetRedundancyGroup(ctx, SetRedundancyGroupRequestObject{Name: "dbs", Body: &NewRedundancyGroup{Members: []string{"db1", "db2"}}})
+	if r, ok := res.(SetRedundancyGroup200JSONResponse); !ok || r.Policy.Mode != All || !reflect.DeepEqual(r.Members, []string{"db1", "db2"}) {
+		t.Errorf("got %+v", res)
+	}
+	if got := healthEvents(taken()); len(got) != 1 || !got["web"] {
+		t.Errorf("got events %v after grouping the databases", got)
+	}
+	prop, _ := api.GetVertexPropagation(ctx, GetVertexPropagationRequestObject{Key: "db2"})
+	if r := prop.(GetVertexPropagation200JSONResponse); r.Group == nil || *r.Group != "dbs" {
+		t.Errorf("got %+v", r)
+	}
+
+	get, _ := api.GetRedundancyGroup(ctx, GetRedundancyGroupRequestObject{Name: "dbs"})
+	if r, ok := get.(GetRedundancyGroup200JSONResponse); !ok || len(r.Members) != 2 {
+		t.Errorf("got %+v", get)
+	}
+	list, _ := api.ListRedundancyGroups(ctx, ListRedundancyGroupsRequestObject{})
+	if r := list.(ListRedundancyGroups200JSONResponse); len(r) != 2 || r[0].Name != "caches" || r[1].Name != "dbs" {
+		t.Errorf("got %+v", r)
+	}
+
+	del, _ := api.DeleteRedundancyGroup(ctx, DeleteRedundancyGroupRequestObject{Name: "dbs"})
+	if _, ok := del.(DeleteRedundancyGroup200Response); !ok {
+		t.Errorf("got %T", del)
+	}
+	if got := healthEvents(taken()); len(got) != 1 || got["web"] {
+		t.Errorf("got events %v after the delete", got)
+	}
+	get, _ = api.GetRedundancyGroup(ctx, GetRedundancyGroupRequestObject{Name: "dbs"})
+	if r, ok := get.(GetRedundancyGroup404JSONResponse); !ok || r.Error != `redundancy group "dbs" not found` {
+		t.Errorf("got %+v", get)
+	}
+	del, _ = api.DeleteRedundancyGroup(ctx, DeleteRedundancyGroupRequestObject{Name: "dbs"})
+	if _, ok := del.(DeleteRedundancyGroup404JSONResponse); !ok {
+		t.Errorf("got %T for a deleted group", del)
+	}
+}
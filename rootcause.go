@@ -0,0 +1,162 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/opsminded/graphlib/v2"
+)
+
+// maxRootCausePaths caps the paths listed per candidate, as a DAG can have
+// exponentially many.
+const maxRootCausePaths = 10
+
+// simplePaths lists up to limit paths from src to dst through adj. The walk
+// only enters vertices that reach dst, so branches that lead elsewhere cost
+// nothing however many paths they hold.
+func simplePaths(src, dst string, adj map[string][]string, limit int) [][]string {
+	reverse := map[string][]string{}
+	for n, ms := range adj {
+		for _, m := range ms {
+			reverse[m] = append(reverse[m], n)
+		}
+	}
+	reaches := hops(dst, reverse)
+
+	paths := [][]string{}
+	if _, ok := reaches[src]; !ok {
+		return paths
+	}
+	var walk func(n string, path []string)
+	walk = func(n string, path []string) {
+		if len(paths) >= limit {
+			return
+		}
+		path = append(path, n)
+		if n == dst {
+			paths = append(paths, append([]string{}, path...))
+			return
+		}
+		for _, m := range adj[n] {
+			if _, ok := reaches[m]; ok {
+				walk(m, path)
+			}
+		}
+	}
+	walk(src, nil)
+	return paths
+}
+
+func (api *API) GetVertexRootCause(ctx context.Context, request GetVertexRootCauseRequestObject) (GetVertexRootCauseResponseObject, error) {
+	serviceSub, err := api.svc().VertexDependencies(request.Key, true)
+	if errors.As(err, &graphlib.VertexNotFoundErr{}) {
+		nf := NotFoundJSONResponse{Code: 404, Error: err.Error()}
+		return GetVertexRootCause404JSONResponse{NotFoundJSONResponse: nf}, nil
+	}
+	if err != nil {
+		ise := InternalServerErrorJSONResponse{Code: 500, Error: err.Error()}
+		return GetVertexRootCause500JSONResponse{InternalServerErrorJSONResponse: ise}, nil
+	}
+
+	view := api.healthView()
+	principal := api.toVertex(serviceSub.Principal, view)
+	edges := api.toEdges(serviceSub.SubGraph.Edges)
+	vertices := map[string]Vertex{principal.Key: principal}
+	for _, v := range api.toVertices(serviceSub.SubGraph.Vertices, view) {
+		vertices[v.Key] = v
+	}
+
+	adj := adjacency(edges, false)
+	depth := hops(principal.Key, adj)
+
+	// below[k] tells whether some dependency of k, direct or not, is unhealthy
+	below := map[string]bool{}
+	var visit func(k string) bool
+	visit = func(k string) bool {
+		if b, ok := below[k]; ok {
+			return b
+		}
+		below[k] = false
+		for _, m := range adj[k] {
+			if !vertices[m].Healthy || visit(m) {
+				below[k] = true
+			}
+		}
+		return below[k]
+	}
+
+	roots := []Vertex{}
+	for k, v := range vertices {
+		if k != principal.Key && !v.Healthy && !visit(k) {
+			roots = append(roots, v)
+		}
+	}
+	if len(roots) == 0 && !principal.Healthy {
+		roots = append(roots, principal)
+	}
+
+	// explains counts the unhealthy vertices, principal included, that
+	// depend on each root
+	reverse := adjacency(edges, true)
+	explains := map[string]int{}
+	for _, r := range roots {
+		for k := range hops(r.Key, reverse) {
+			if !vertices[k].Healthy {
+				explains[r.Key]++
+			}
+		}
+	}
+
+	sort.Slice(roots, func(i, j int) bool {
+		a, b := roots[i], roots[j]
+		if depth[a.Key] != depth[b.Key] {
+			return depth[a.Key] > depth[b.Key]
+		}
+		if explains[a.Key] != explains[b.Key] {
+			return explains[a.Key] > explains[b.Key]
+		}
+		return a.Key < b.Key
+	})
+
+	onPath := map[string]struct{}{principal.Key: {}}
+	pathEdges := map[string]struct{}{}
+	candidates := []RootCauseCandidate{}
+	for i, r := range roots {
+		paths := simplePaths(principal.Key, r.Key, adj, maxRootCausePaths)
+		for _, p := range paths {
+			for j, k := range p {
+				onPath[k] = struct{}{}
+				if j > 0 {
+					pathEdges[edgeKey(p[j-1], k)] = struct{}{}
+				}
+			}
+		}
+		candidates = append(candidates, RootCauseCandidate{
+			Rank:     i + 1,
+			Vertex:   r,
+			Depth:    depth[r.Key],
+			Explains: explains[r.Key],
+			Paths:    paths,
+		})
+	}
+
+	sub := Subgraph{
+		Title:      "Causa raiz de " + request.Key,
+		All:        true,
+		Principal:  principal,
+		Edges:      []Edge{},
+		Vertices:   []Vertex{},
+		Highlights: roots,
+	}
+	for _, k := range sortedKeys(onPath) {
+		sub.Vertices = append(sub.Vertices, vertices[k])
+	}
+	for _, e := range edges {
+		if _, ok := pathEdges[edgeKey(e.Source, e.Target)]; ok {
+			sub.Edges = append(sub.Edges, e)
+		}
+	}
+
+	return GetVertexRootCause200JSONResponse(RootCause{Subgraph: sub, Candidates: candidates}), nil
+}
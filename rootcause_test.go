@@ -0,0 +1,100 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestSimplePaths(t *testing.T) {
+	adj := map[string][]string{
+		"app":   {"cache", "queue", "web"},
+		"web":   {"db", "queue"},
+		"queue": {"db"},
+		"cache": {"disk"},
+	}
+
+	cases := []struct {
+		name     string
+		src, dst string
+		limit    int
+		want     [][]string
+	}{
+		{name: "every path", src: "app", dst: "db", limit: 10, want: [][]string{{"app", "queue", "db"}, {"app", "web", "db"}, {"app", "web", "queue", "db"}}},
+		{name: "limited", src: "app", dst: "db", limit: 2, want: [][]string{{"app", "queue", "db"}, {"app", "web", "db"}}},
+		{name: "to itself", src: "db", dst: "db", limit: 10, want: [][]string{{"db"}}},
+		{name: "unreachable", src: "cache", dst: "db", limit: 10, want: [][]string{}},
+		{name: "unknown", src: "gone", dst: "db", limit: 10, want: [][]string{}},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := simplePaths(c.src, c.dst, adj, c.limit); !reflect.DeepEqual(got, c.want) {
+				t.Errorf("got %v, want %v", got, c.want)
+			}
+		})
+	}
+}
+
+func TestSimplePathsDeadEnds(t *testing.T) {
+	// 40 layers of two vertices, each linked to both of the next layer, hold
+	// 2^40 paths that never reach db
+	adj := map[string][]string{"app": {"db", "l0a", "l0b"}}
+	for i := range 40 {
+		next := []string{fmt.Sprintf("l%da", i+1), fmt.Sprintf("l%db", i+1)}
+		adj[fmt.Sprintf("l%da", i)] = next
+		adj[fmt.Sprintf("l%db", i)] = next
+	}
+
+	got := simplePaths("app", "db", adj, maxRootCausePaths)
+	if want := [][]string{{"app", "db"}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestGetVertexRootCause(t *testing.T) {
+	api := testAPI(t, "app>web", "web>db", "app>cache", "web>disk", "cache>disk")
+	ctx := context.Background()
+	for _, k := range []string{"web", "db", "disk"} {
+		if _, err := api.MarkVertexUnhealthy(ctx, MarkVertexUnhealthyRequestObject{Key: k}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	res, err := api.GetVertexRootCause(ctx, GetVertexRootCauseRequestObject{Key: "app"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	rc, ok := res.(GetVertexRootCause200JSONResponse)
+	if !ok {
+		t.Fatalf("got %T", res)
+	}
+
+	// web is unhealthy only because of what is below it
+	want := []struct {
+		key      string
+		depth    int
+		explains int
+		paths    [][]string
+	}{
+		{key: "db", depth: 2, explains: 2, paths: [][]string{{"app", "web", "db"}}},
+		{key: "disk", depth: 2, explains: 2, paths: [][]string{{"app", "cache", "disk"}, {"app", "web", "disk"}}},
+	}
+	if len(rc.Candidates) != len(want) {
+		t.Fatalf("got %+v", rc.Candidates)
+	}
+	for i, w := range want {
+		c := rc.Candidates[i]
+		if c.Rank != i+1 || c.Vertex.Key != w.key || c.Depth != w.depth || c.Explains != w.explains || !reflect.DeepEqual(c.Paths, w.paths) {
+			t.Errorf("candidate %d: got %+v, want %+v", i+1, c, w)
+		}
+	}
+	if len(rc.Subgraph.Vertices) != 5 || len(rc.Subgraph.Edges) != 5 {
+		t.Errorf("got %d vertices and %d edges on the paths", len(rc.Subgraph.Vertices), len(rc.Subgraph.Edges))
+	}
+
+	res, _ = api.GetVertexRootCause(ctx, GetVertexRootCauseRequestObject{Key: "gone"})
+	if _, ok := res.(GetVertexRootCause404JSONResponse); !ok {
+		t.Errorf("got %T for an unknown vertex", res)
+	}
+}
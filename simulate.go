@@ -0,0 +1,160 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"sort"
+)
+
+// simulateHealth returns the health of every vertex before and after
+// failing and recovering the given keys on a copy of the current state. Both
+// sides propagate every failure to the dependents as the propagation
+// policies say, so failures the health check loop has not spread yet do not
+// show up as changes.
+func (api *API) simulateHealth(fail, recover []string) (before, after map[string]Vertex, err error) {
+	api.mu.RLock()
+	defer api.mu.RUnlock()
+
+	view := api.healthViewLocked()
+	live := make(map[string]Vertex, len(api.catalog.vertices))
+	for _, k := range api.catalog.vertexKeys() {
+		v, err := api.graph.GetVertex(k)
+		if err != nil {
+			return nil, nil, err
+		}
+		live[k] = api.toVertex(v, view)
+	}
+
+	own := make(map[string]bool, len(view.own))
+	for k, healthy := range view.own {
+		own[k] = healthy
+	}
+	seeds := make(map[string]string, len(view.seeds))
+	for k, from := range view.seeds {
+		seeds[k] = from
+	}
+	for _, k := range recover {
+		own[k] = true
+		delete(seeds, k)
+	}
+	for _, k := range fail {
+		own[k] = false
+		delete(seeds, k)
+	}
+
+	return api.propagate(live, view.own, view.seeds, view), api.propagate(live, own, seeds, view), nil
+}
+
+// propagate returns the vertices with the health they get when each one
+// alone is as healthy as own says. seeds keep the source of the failures
+// left over from a propagation.
+func (api *API) propagate(vertices map[string]Vertex, own map[string]bool, seeds map[string]string, view healthView) map[string]Vertex {
+	eff := api.propagation.effective(own, view.deps, view.classes)
+	from := inheritedSources(view.deps, own, eff, seeds)
+
+	res := make(map[string]Vertex, len(vertices))
+	for k, v := range vertices {
+		r := withHealth(v, eff[k])
+		if !eff[k] {
+			r.HealthOrigin, r.InheritedFrom = Direct, nil
+			if f, ok := from[k]; ok {
+				r.HealthOrigin = Inherited
+				r.InheritedFrom = &f
+			}
+		}
+		res[k] = r
+	}
+	return res
+}
+
+func (api *API) Simulate(ctx context.Context, request SimulateRequestObject) (SimulateResponseObject, error) {
+	if request.Body == nil {
+		ir := InvalidRequestJSONResponse{Code: 422, Error: "request body is required"}
+		return Simulate422JSONResponse{InvalidRequestJSONResponse: ir}, nil
+	}
+	body := *request.Body
+
+	var fail, recover []string
+	if body.Fail != nil {
+		fail = *body.Fail
+	}
+	if body.Recover != nil {
+		recover = *body.Recover
+	}
+
+	failing := make(map[string]struct{}, len(fail))
+	for _, k := range fail {
+		failing[k] = struct{}{}
+	}
+	for _, k := range recover {
+		if _, ok := failing[k]; ok {
+			ir := InvalidRequestJSONResponse{Code: 422, Error: fmt.Sprintf("vertex %q cannot both fail and recover", k)}
+			return Simulate422JSONResponse{InvalidRequestJSONResponse: ir}, nil
+		}
+	}
+
+	before, after, err := api.simulateHealth(fail, recover)
+	if err != nil {
+		ise := InternalServerErrorJSONResponse{Code: 500, Error: err.Error()}
+		return Simulate500JSONResponse{InternalServerErrorJSONResponse: ise}, nil
+	}
+	for _, k := range append(append([]string{}, fail...), recover...) {
+		if _, ok := before[k]; !ok {
+			ir := InvalidRequestJSONResponse{Code: 422, Error: fmt.Sprintf("simulation references unknown vertex %q", k)}
+			return Simulate422JSONResponse{InvalidRequestJSONResponse: ir}, nil
+		}
+	}
+
+	sub, failed, err := api.exportSubgraph(ctx, ExportGraphParams{Scope: body.Scope, Key: body.Key, Target: body.Target, All: body.All})
+	if err != nil {
+		ise := InternalServerErrorJSONResponse{Code: 500, Error: err.Error()}
+		return Simulate500JSONResponse{InternalServerErrorJSONResponse: ise}, nil
+	}
+	switch r := failed.(type) {
+	case ExportGraph404JSONResponse:
+		return Simulate404JSONResponse(r), nil
+	case ExportGraph409JSONResponse:
+		return Simulate409JSONResponse(r), nil
+	case ExportGraph422JSONResponse:
+		return Simulate422JSONResponse(r), nil
+	}
+
+	result := SimulationResult{Changes: []HealthDiff{}}
+	keys := make([]string, 0, len(before))
+	for k := range before {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		b, a := before[k], after[k]
+		if b.Healthy == a.Healthy {
+			continue
+		}
+		result.Changes = append(result.Changes, HealthDiff{Vertex: a, Before: b.Healthy, After: a.Healthy})
+		if a.Healthy {
+			result.BecameHealthy++
+		} else {
+			result.BecameUnhealthy++
+		}
+	}
+
+	// the subgraph was read from the live graph, so swap in simulated health
+	simulated := func(v Vertex) Vertex {
+		if s, ok := after[v.Key]; ok {
+			v = withHealth(v, s.Healthy)
+			v.HealthOrigin, v.InheritedFrom = s.HealthOrigin, s.InheritedFrom
+		}
+		return v
+	}
+	sub.Principal = simulated(sub.Principal)
+	sub.Highlights = []Vertex{}
+	for i, v := range sub.Vertices {
+		sub.Vertices[i] = simulated(v)
+		if before[v.Key].Healthy != sub.Vertices[i].Healthy {
+			sub.Highlights = append(sub.Highlights, sub.Vertices[i])
+		}
+	}
+	result.Subgraph = sub
+
+	return Simulate200JSONResponse(result), nil
+}
@@ -0,0 +1,176 @@
+package api
+
+import (
+	"context"
+	"testing"
+)
+
+func simulate(t *testing.T, api *API, body SimulationRequest) SimulationResult {
+	t.Helper()
+	res, err := api.Simulate(context.Background(), SimulateRequestObject{Body: &body})
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, ok := res.(Simulate200JSONResponse)
+	if !ok {
+		t.Fatalf("got %+v", res)
+	}
+	return SimulationResult(r)
+}
+
+// changed maps the keys in the diff to their health after the simulation.
+func changed(r SimulationResult) map[string]bool {
+	got := map[string]bool{}
+	for _, c := range r.Changes {
+		if c.Before == c.After || c.Vertex.Healthy != c.After {
+			got["inconsistent "+c.Vertex.Key] = true
+		}
+		got[c.Vertex.Key] = c.After
+	}
+	return got
+}
+
+func TestSimulateFailure(t *testing.T) {
+	api := testAPI(t, "web>db", "app>web", "app>cache")
+
+	r := simulate(t, api, SimulationRequest{Fail: &[]string{"db"}})
+	if got := changed(r); len(got) != 3 || got["db"] || got["web"] || got["app"] {
+		t.Errorf("got changes %v", got)
+	}
+	if r.BecameUnhealthy != 3 || r.BecameHealthy != 0 {
+		t.Errorf("got %d unhealthy and %d healthy", r.BecameUnhealthy, r.BecameHealthy)
+	}
+	for _, c := range r.Changes {
+		origin, from := c.Vertex.HealthOrigin, ""
+		if c.Vertex.InheritedFrom != nil {
+			from = *c.Vertex.InheritedFrom
+		}
+		want, wantFrom := Inherited, "db"
+		if c.Vertex.Key == "db" {
+			want, wantFrom = Direct, ""
+		}
+		if origin != want || from != wantFrom {
+			t.Errorf("%s: got %s from %q", c.Vertex.Key, origin, from)
+		}
+	}
+
+	// the live state is untouched
+	for _, k := range []string{"db", "web", "app"} {
+		if v, _ := api.graph.GetVertex(k); !v.Healthy {
+			t.Errorf("%s is unhealthy after the simulation", k)
+		}
+	}
+	if len(api.history.between("db", nil, nil)) != 0 {
+		t.Error("the simulation was recorded")
+	}
+}
+
+func TestSimulateRecovery(t *testing.T) {
+	api := testAPI(t, "web>db", "app>web", "app>cache")
+	ctx := context.Background()
+	for _, k := range []string{"db", "cache"} {
+		if _, err := api.MarkVertexUnhealthy(ctx, MarkVertexUnhealthyRequestObject{Key: k}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// app still fails through the cache
+	r := simulate(t, api, SimulationRequest{Recover: &[]string{"db"}})
+	if got := changed(r); len(got) != 2 || !got["db"] || !got["web"] {
+		t.Errorf("got changes %v", got)
+	}
+	if r.BecameHealthy != 2 || r.BecameUnhealthy != 0 {
+		t.Errorf("got %d healthy and %d unhealthy", r.BecameHealthy, r.BecameUnhealthy)
+	}
+
+	r = simulate(t, api, SimulationRequest{Recover: &[]string{"db", "cache"}})
+	if got := changed(r); len(got) != 4 || !got["app"] {
+		t.Errorf("got changes %v", got)
+	}
+
+	// a failure and a recovery at once
+	r = simulate(t, api, SimulationRequest{Fail: &[]string{"web"}, Recover: &[]string{"db"}})
+	if got := changed(r); len(got) != 1 || !got["db"] {
+		t.Errorf("got changes %v", got)
+	}
+}
+
+func TestSimulatePolicy(t *testing.T) {
+	api := testAPI(t, "web>db", "app>web", "app>cache")
+	api.propagation.setVertex("app", PropagationPolicy{Mode: All})
+
+	// app needs web and the cache to fail
+	r := simulate(t, api, SimulationRequest{Fail: &[]string{"db"}})
+	if got := changed(r); len(got) != 2 || got["db"] || got["web"] {
+		t.Errorf("got changes %v", got)
+	}
+	r = simulate(t, api, SimulationRequest{Fail: &[]string{"db", "cache"}})
+	if got := changed(r); len(got) != 4 || got["app"] {
+		t.Errorf("got changes %v", got)
+	}
+}
+
+func TestSimulateSubgraph(t *testing.T) {
+	api := testAPI(t, "web>db", "app>web", "app>cache")
+	scope, key := "dependencies", "app"
+
+	r := simulate(t, api, SimulationRequest{Fail: &[]string{"db"}, Scope: &scope, Key: &key})
+	sub := r.Subgraph
+	if sub.Principal.Key != "app" || sub.Principal.Healthy {
+		t.Errorf("got principal %+v", sub.Principal)
+	}
+	healthy := map[string]bool{}
+	for _, v := range sub.Vertices {
+		healthy[v.Key] = v.Healthy
+	}
+	if healthy["web"] || !healthy["cache"] {
+		t.Errorf("got %v", healthy)
+	}
+	highlighted := map[string]bool{}
+	for _, v := range sub.Highlights {
+		highlighted[v.Key] = true
+	}
+	if !highlighted["web"] || highlighted["cache"] {
+		t.Errorf("got highlights %v", highlighted)
+	}
+	// the diff covers the whole graph, not the subgraph
+	if len(r.Changes) != 3 {
+		t.Errorf("got %d changes", len(r.Changes))
+	}
+}
+
+func TestSimulateErrors(t *testing.T) {
+	api := testAPI(t, "web>db")
+	ctx := context.Background()
+	scope, gone := "dependents", "gone"
+
+	cases := []struct {
+		name string
+		body *SimulationRequest
+		want any
+	}{
+		{name: "no body", want: Simulate422JSONResponse{}},
+		{name: "fail and recover", body: &SimulationRequest{Fail: &[]string{"db"}, Recover: &[]string{"db"}}, want: Simulate422JSONResponse{}},
+		{name: "unknown vertex", body: &SimulationRequest{Fail: &[]string{"gone"}}, want: Simulate422JSONResponse{}},
+		{name: "unknown key", body: &SimulationRequest{Fail: &[]string{"db"}, Scope: &scope, Key: &gone}, want: Simulate404JSONResponse{}},
+		{name: "scope without key", body: &SimulationRequest{Fail: &[]string{"db"}, Scope: &scope}, want: Simulate422JSONResponse{}},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			res, err := api.Simulate(ctx, SimulateRequestObject{Body: c.body})
+			if err != nil {
+				t.Fatal(err)
+			}
+			switch c.want.(type) {
+			case Simulate404JSONResponse:
+				if _, ok := res.(Simulate404JSONResponse); !ok {
+					t.Errorf("got %+v, want 404", res)
+				}
+			default:
+				if _, ok := res.(Simulate422JSONResponse); !ok {
+					t.Errorf("got %+v, want 422", res)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,164 @@
+package api
+
+import (
+	"context"
+	"fmt"
+)
+
+// waves groups the vertices so each one comes after all its dependencies.
+// The members of a cycle share a wave.
+func (s structure) waves() [][]string {
+	components := s.components()
+	comp := make(map[string]int, len(s.keys))
+	for i, c := range components {
+		for _, k := range c {
+			comp[k] = i
+		}
+	}
+
+	level := map[int]int{}
+	var depth func(i int) int
+	depth = func(i int) int {
+		if l, ok := level[i]; ok {
+			return l
+		}
+		l := 0
+		for _, k := range components[i] {
+			for _, m := range s.next[k] {
+				if j, ok := comp[m]; ok && j != i {
+					l = max(l, depth(j)+1)
+				}
+			}
+		}
+		level[i] = l
+		return l
+	}
+
+	waves := [][]string{}
+	for _, k := range s.keys {
+		l := depth(comp[k])
+		for len(waves) <= l {
+			waves = append(waves, []string{})
+		}
+		waves[l] = append(waves[l], k)
+	}
+	return waves
+}
+
+// startupOrder orders the vertices of sub, principal included. The hidden
+// vertices still hold their dependents back but are left out of the result,
+// and the waves they leave empty are dropped.
+func startupOrder(sub Subgraph, hidden map[string]struct{}) StartupOrder {
+	vertices := map[string]Vertex{}
+	if sub.Principal.Key != "" {
+		vertices[sub.Principal.Key] = sub.Principal
+	}
+	for _, v := range sub.Vertices {
+		vertices[v.Key] = v
+	}
+	edges := make(map[string]Edge, len(sub.Edges))
+	for _, e := range sub.Edges {
+		edges[edgeKey(e.Source, e.Target)] = e
+	}
+	s := newStructure(vertices, edges)
+
+	res := StartupOrder{
+		Subgraph: sub,
+		Waves:    []StartupWave{},
+		Cycles:   s.cycles(),
+	}
+	res.Ordered = len(res.Cycles) == 0
+	for _, keys := range s.waves() {
+		wave := StartupWave{Wave: len(res.Waves), Vertices: []Vertex{}}
+		for _, k := range keys {
+			if _, ok := hidden[k]; !ok {
+				wave.Vertices = append(wave.Vertices, vertices[k])
+			}
+		}
+		if len(wave.Vertices) > 0 {
+			res.Waves = append(res.Waves, wave)
+		}
+	}
+	if len(hidden) == 0 {
+		return res
+	}
+
+	shown := func(vs []Vertex) []Vertex {
+		kept := []Vertex{}
+		for _, v := range vs {
+			if _, ok := hidden[v.Key]; !ok {
+				kept = append(kept, v)
+			}
+		}
+		return kept
+	}
+	shownEdges := func(es []Edge) []Edge {
+		kept := []Edge{}
+		for _, e := range es {
+			_, src := hidden[e.Source]
+			_, tgt := hidden[e.Target]
+			if !src && !tgt {
+				kept = append(kept, e)
+			}
+		}
+		return kept
+	}
+	res.Subgraph.Vertices = shown(res.Subgraph.Vertices)
+	res.Subgraph.Highlights = shown(res.Subgraph.Highlights)
+	res.Subgraph.Edges = shownEdges(res.Subgraph.Edges)
+	for i := range res.Cycles {
+		res.Cycles[i].Vertices = shown(res.Cycles[i].Vertices)
+		res.Cycles[i].Edges = shownEdges(res.Cycles[i].Edges)
+	}
+	return res
+}
+
+// GetVertexStartupOrder walks the dependencies without the class filters,
+// so a vertex still comes after what it reaches through filtered ones, and
+// applies the filters to the result.
+func (api *API) GetVertexStartupOrder(ctx context.Context, request GetVertexStartupOrderRequestObject) (GetVertexStartupOrderResponseObject, error) {
+	walk, err := newTraversal(request.Params.Depth, request.Params.IncludeClass, request.Params.ExcludeClass, request.Params.StopAtClass)
+	if err != nil {
+		ir := InvalidRequestJSONResponse{Code: 422, Error: err.Error()}
+		return GetVertexStartupOrder422JSONResponse{InvalidRequestJSONResponse: ir}, nil
+	}
+
+	all := true
+	params := GetVertexDependenciesParams{
+		All:         &all,
+		EdgeClass:   request.Params.EdgeClass,
+		Depth:       request.Params.Depth,
+		StopAtClass: request.Params.StopAtClass,
+	}
+	res, err := api.GetVertexDependencies(ctx, GetVertexDependenciesRequestObject{Key: request.Key, Params: params})
+	if err != nil {
+		return nil, err
+	}
+
+	switch r := res.(type) {
+	case GetVertexDependencies200JSONResponse:
+		sub := Subgraph(r)
+		sub.Title = "Ordem de inicialização de " + request.Key
+		hidden := map[string]struct{}{}
+		for _, v := range sub.Vertices {
+			if !walk.shows(request.Key, v) {
+				hidden[v.Key] = struct{}{}
+			}
+		}
+		return GetVertexStartupOrder200JSONResponse(startupOrder(sub, hidden)), nil
+	case GetVertexDependencies404JSONResponse:
+		return GetVertexStartupOrder404JSONResponse(r), nil
+	case GetVertexDependencies422JSONResponse:
+		return GetVertexStartupOrder422JSONResponse(r), nil
+	case GetVertexDependencies500JSONResponse:
+		return GetVertexStartupOrder500JSONResponse(r), nil
+	}
+	ise := InternalServerErrorJSONResponse{Code: 500, Error: fmt.Sprintf("dependencies query failed for %q", request.Key)}
+	return GetVertexStartupOrder500JSONResponse{InternalServerErrorJSONResponse: ise}, nil
+}
+
+func (api *API) GetStartupOrder(ctx context.Context, request GetStartupOrderRequestObject) (GetStartupOrderResponseObject, error) {
+	sub := api.wholeGraph()
+	sub.Title = "Ordem de inicialização do grafo"
+	return GetStartupOrder200JSONResponse(startupOrder(sub, nil)), nil
+}
@@ -0,0 +1,133 @@
+package api
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestWaves(t *testing.T) {
+	cases := []struct {
+		name  string
+		lone  []string
+		pairs []string
+		want  [][]string
+	}{
+		{
+			name:  "chain starts from the last dependency",
+			pairs: []string{"app>db", "db>disk"},
+			want:  [][]string{{"disk"}, {"db"}, {"app"}},
+		},
+		{
+			name:  "independent vertices share a wave",
+			lone:  []string{"z"},
+			pairs: []string{"a>c", "b>c"},
+			want:  [][]string{{"c", "z"}, {"a", "b"}},
+		},
+		{
+			name:  "the longest chain decides",
+			pairs: []string{"a>b", "b>c", "a>c"},
+			want:  [][]string{{"c"}, {"b"}, {"a"}},
+		},
+		{
+			name:  "a cycle shares a wave",
+			pairs: []string{"a>b", "b>a", "b>c", "d>a"},
+			want:  [][]string{{"c"}, {"a", "b"}, {"d"}},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := testStructure(c.lone, c.pairs...).waves()
+			if !reflect.DeepEqual(got, c.want) {
+				t.Errorf("got %v, want %v", got, c.want)
+			}
+		})
+	}
+}
+
+func TestVertexStartupOrderFilters(t *testing.T) {
+	api := testAPI(t)
+	for k, class := range map[string]string{"app": "server", "web": "server", "lb": "network", "db": "database"} {
+		api.AddVertex(k, k, class, true)
+	}
+	for _, p := range [][2]string{{"app", "web"}, {"web", "lb"}, {"lb", "db"}} {
+		if err := api.AddEdge(p[0], p[1], "runs-on", "usa"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	keys := func(vs []Vertex) []string {
+		got := []string{}
+		for _, v := range vs {
+			got = append(got, v.Key)
+		}
+		sort.Strings(got)
+		return got
+	}
+
+	cases := []struct {
+		name     string
+		params   GetVertexStartupOrderParams
+		waves    [][]string
+		vertices []string
+		edges    int
+	}{
+		{name: "everything", waves: [][]string{{"db"}, {"lb"}, {"web"}, {"app"}}, vertices: []string{"app", "db", "lb", "web"}, edges: 3},
+		{
+			// db still starts before web, through the load balancer
+			name:     "excluded class",
+			params:   GetVertexStartupOrderParams{ExcludeClass: &ExcludeClass{"network"}},
+			waves:    [][]string{{"db"}, {"web"}, {"app"}},
+			vertices: []string{"app", "db", "web"},
+			edges:    1,
+		},
+		{
+			name:     "included class",
+			params:   GetVertexStartupOrderParams{IncludeClass: &IncludeClass{"server"}},
+			waves:    [][]string{{"web"}, {"app"}},
+			vertices: []string{"app", "web"},
+			edges:    1,
+		},
+		{
+			name:     "stop class",
+			params:   GetVertexStartupOrderParams{StopAtClass: &StopAtClass{"network"}},
+			waves:    [][]string{{"lb"}, {"web"}, {"app"}},
+			vertices: []string{"app", "lb", "web"},
+			edges:    2,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			res, err := api.GetVertexStartupOrder(context.Background(), GetVertexStartupOrderRequestObject{Key: "app", Params: c.params})
+			if err != nil {
+				t.Fatal(err)
+			}
+			order, ok := res.(GetVertexStartupOrder200JSONResponse)
+			if !ok {
+				t.Fatalf("got %T", res)
+			}
+			waves := [][]string{}
+			for i, w := range order.Waves {
+				if w.Wave != i {
+					t.Errorf("wave %d is numbered %d", i, w.Wave)
+				}
+				waves = append(waves, keys(w.Vertices))
+			}
+			if !reflect.DeepEqual(waves, c.waves) {
+				t.Errorf("got waves %v, want %v", waves, c.waves)
+			}
+			if got := keys(order.Subgraph.Vertices); !reflect.DeepEqual(got, c.vertices) {
+				t.Errorf("got vertices %v, want %v", got, c.vertices)
+			}
+			if len(order.Subgraph.Edges) != c.edges {
+				t.Errorf("got edges %+v", order.Subgraph.Edges)
+			}
+		})
+	}
+
+	depth := 0
+	res, _ := api.GetVertexStartupOrder(context.Background(), GetVertexStartupOrderRequestObject{Key: "app", Params: GetVertexStartupOrderParams{Depth: &depth}})
+	if _, ok := res.(GetVertexStartupOrder422JSONResponse); !ok {
+		t.Errorf("got %T for depth 0", res)
+	}
+}
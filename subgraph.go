@@ -0,0 +1,366 @@
+package api
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/opsminded/graphlib/v2"
+)
+
+// toVertex maps a graphlib vertex to an API vertex with its health in view.
+// LastCheck comes from the health history, which has its own lock, so api.mu
+// may be held.
+func (api *API) toVertex(v graphlib.Vertex, view healthView) Vertex {
+	healthy, from := view.of(v)
+
+	r := api.history.current(v.Key, healthy)
+	vertex := Vertex{
+		Key:          v.Key,
+		Label:        v.Label,
+		Class:        v.Class,
+		Healthy:      healthy,
+		Status:       r.status,
+		Severity:     r.severity,
+		LastCheck:    api.history.lastCheck(v.Key),
+		HealthOrigin: Direct,
+	}
+	if r.reason != "" {
+		vertex.Reason = &r.reason
+	}
+	if from != "" {
+		vertex.HealthOrigin = Inherited
+		vertex.InheritedFrom = &from
+	}
+	return vertex
+}
+
+// withHealth returns v with the given health, resetting the status when the
+// health changes.
+func withHealth(v Vertex, healthy bool) Vertex {
+	if v.Healthy == healthy {
+		return v
+	}
+	v.Healthy = healthy
+	v.Status = Down
+	if healthy {
+		v.Status = Healthy
+	}
+	v.Severity = nil
+	v.Reason = nil
+	v.HealthOrigin = Direct
+	v.InheritedFrom = nil
+	return v
+}
+
+func (api *API) toVertices(vs []graphlib.Vertex, view healthView) []Vertex {
+	vertices := []Vertex{}
+	for _, v := range vs {
+		vertices = append(vertices, api.toVertex(v, view))
+	}
+	return vertices
+}
+
+// toEdges maps graphlib edges to API edges. graphlib only knows the
+// endpoints, so class and label come from the catalog.
+func (api *API) toEdges(es []graphlib.Edge) []Edge {
+	api.mu.RLock()
+	defer api.mu.RUnlock()
+
+	edges := []Edge{}
+	for _, e := range es {
+		edge := Edge{
+			Key:    edgeKey(e.Source, e.Target),
+			Source: e.Source,
+			Target: e.Target,
+		}
+		if rec, ok := api.catalog.edges[edgeKey(e.Source, e.Target)]; ok {
+			edge.Class = rec.Class
+			edge.Label = rec.Label
+		}
+		edges = append(edges, edge)
+	}
+	return edges
+}
+
+// filterEdgeClass keeps the edges of the given classes and the vertices that
+// can still be reached from root through them. reverse walks from target to
+// source, as dependents do.
+func filterEdgeClass(root string, vertices []Vertex, edges []Edge, classes []string, reverse bool) ([]Vertex, []Edge) {
+	allowed := make(map[string]struct{}, len(classes))
+	for _, c := range classes {
+		allowed[c] = struct{}{}
+	}
+
+	next := make(map[string][]Edge, len(edges))
+	for _, e := range edges {
+		if _, ok := allowed[e.Class]; !ok {
+			continue
+		}
+		from := e.Source
+		if reverse {
+			from = e.Target
+		}
+		next[from] = append(next[from], e)
+	}
+
+	reached := map[string]struct{}{root: {}}
+	keptEdges := []Edge{}
+	stack := []string{root}
+	for len(stack) > 0 {
+		n := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		for _, e := range next[n] {
+			keptEdges = append(keptEdges, e)
+			to := e.Target
+			if reverse {
+				to = e.Source
+			}
+			if _, seen := reached[to]; !seen {
+				reached[to] = struct{}{}
+				stack = append(stack, to)
+			}
+		}
+	}
+
+	keptVertices := []Vertex{}
+	for _, v := range vertices {
+		if _, ok := reached[v.Key]; ok {
+			keptVertices = append(keptVertices, v)
+		}
+	}
+	return keptVertices, keptEdges
+}
+
+// wholeGraph returns every vertex and edge of the catalog as a subgraph.
+func (api *API) wholeGraph() Subgraph {
+	api.mu.RLock()
+	defer api.mu.RUnlock()
+
+	sub := Subgraph{
+		Title:      "Grafo completo",
+		All:        true,
+		Edges:      []Edge{},
+		Vertices:   []Vertex{},
+		Highlights: []Vertex{},
+	}
+
+	view := api.healthViewLocked()
+	for _, k := range api.catalog.vertexKeys() {
+		v, err := api.graph.GetVertex(k)
+		if err != nil {
+			continue
+		}
+		sub.Vertices = append(sub.Vertices, api.toVertex(v, view))
+	}
+	for _, k := range api.catalog.edgeKeys() {
+		sub.Edges = append(sub.Edges, toEdge(api.catalog.edges[k]))
+	}
+	return sub
+}
+
+// adjacency indexes edges by source, or by target when reverse is set.
+func adjacency(edges []Edge, reverse bool) map[string][]string {
+	adj := make(map[string][]string, len(edges))
+	for _, e := range edges {
+		if reverse {
+			adj[e.Target] = append(adj[e.Target], e.Source)
+		} else {
+			adj[e.Source] = append(adj[e.Source], e.Target)
+		}
+	}
+	for k := range adj {
+		sort.Strings(adj[k])
+	}
+	return adj
+}
+
+// hops returns the shortest hop distance from root to every vertex it
+// reaches through adj.
+func hops(root string, adj map[string][]string) map[string]int {
+	dist := map[string]int{root: 0}
+	queue := []string{root}
+	for len(queue) > 0 {
+		n := queue[0]
+		queue = queue[1:]
+		for _, m := range adj[n] {
+			if _, seen := dist[m]; !seen {
+				dist[m] = dist[n] + 1
+				queue = append(queue, m)
+			}
+		}
+	}
+	return dist
+}
+
+func sortedKeys(set map[string]struct{}) []string {
+	keys := make([]string, 0, len(set))
+	for k := range set {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// traversal limits how far a dependency walk goes and which vertices it
+// returns. A zero depth means no limit.
+type traversal struct {
+	depth   int
+	include map[string]struct{}
+	exclude map[string]struct{}
+	stop    map[string]struct{}
+}
+
+func newTraversal(depth *Depth, include *IncludeClass, exclude *ExcludeClass, stop *StopAtClass) (traversal, error) {
+	set := func(classes *[]string) map[string]struct{} {
+		m := map[string]struct{}{}
+		if classes != nil {
+			for _, c := range *classes {
+				m[c] = struct{}{}
+			}
+		}
+		return m
+	}
+
+	t := traversal{include: set(include), exclude: set(exclude), stop: set(stop)}
+	if depth != nil {
+		if *depth < 1 {
+			return t, fmt.Errorf("depth must be at least 1, got %d", *depth)
+		}
+		t.depth = *depth
+	}
+	return t, nil
+}
+
+// limited reports whether the traversal changes anything beyond the
+// boolean all parameter.
+func (t traversal) limited() bool {
+	return t.depth > 0 || len(t.include) > 0 || len(t.exclude) > 0 || len(t.stop) > 0
+}
+
+// shows reports whether the class filters let v through. The root always
+// passes.
+func (t traversal) shows(root string, v Vertex) bool {
+	if v.Key == root {
+		return true
+	}
+	if _, ok := t.include[v.Class]; len(t.include) > 0 && !ok {
+		return false
+	}
+	_, excluded := t.exclude[v.Class]
+	return !excluded
+}
+
+// walk keeps the vertices and edges reached from root within the depth,
+// without expanding stop classes, and then drops the vertices filtered out by
+// class along with their edges. Dependencies that pass only through dropped
+// vertices are lost, so callers that need them, such as the startup order,
+// leave the class filters out of the walk and hide the vertices afterwards. reverse walks from target
+// to source, as dependents do. The input order is preserved.
+func (t traversal) walk(root string, vertices []Vertex, edges []Edge, reverse bool) ([]Vertex, []Edge) {
+	class := make(map[string]string, len(vertices))
+	for _, v := range vertices {
+		class[v.Key] = v.Class
+	}
+
+	next := make(map[string][]Edge, len(edges))
+	for _, e := range edges {
+		from := e.Source
+		if reverse {
+			from = e.Target
+		}
+		next[from] = append(next[from], e)
+	}
+
+	dist := map[string]int{root: 0}
+	used := map[string]struct{}{}
+	queue := []string{root}
+	for len(queue) > 0 {
+		n := queue[0]
+		queue = queue[1:]
+
+		if t.depth > 0 && dist[n] >= t.depth {
+			continue
+		}
+		if _, stop := t.stop[class[n]]; stop && n != root {
+			continue
+		}
+		for _, e := range next[n] {
+			used[e.Key] = struct{}{}
+			to := e.Target
+			if reverse {
+				to = e.Source
+			}
+			if _, seen := dist[to]; !seen {
+				dist[to] = dist[n] + 1
+				queue = append(queue, to)
+			}
+		}
+	}
+
+	keep := func(v Vertex) bool {
+		if _, ok := dist[v.Key]; !ok {
+			return false
+		}
+		return t.shows(root, v)
+	}
+
+	kept := map[string]struct{}{}
+	keptVertices := []Vertex{}
+	for _, v := range vertices {
+		if keep(v) {
+			kept[v.Key] = struct{}{}
+			keptVertices = append(keptVertices, v)
+		}
+	}
+	keptEdges := []Edge{}
+	for _, e := range edges {
+		_, ok := used[e.Key]
+		_, src := kept[e.Source]
+		_, tgt := kept[e.Target]
+		if ok && src && tgt {
+			keptEdges = append(keptEdges, e)
+		}
+	}
+	return keptVertices, keptEdges
+}
+
+// neighborhood walks the dependencies and the dependents of key within the
+// traversal limits, one hop by default, and merges both sides.
+func (api *API) neighborhood(key string, t traversal) ([]Vertex, []Edge, error) {
+	if t.depth == 0 {
+		t.depth = 1
+	}
+
+	vertices := []Vertex{}
+	edges := []Edge{}
+	seenVertices := map[string]struct{}{}
+	seenEdges := map[string]struct{}{}
+	view := api.healthView()
+
+	for _, reverse := range []bool{false, true} {
+		query := api.svc().VertexDependencies
+		if reverse {
+			query = api.svc().VertexDependents
+		}
+		res, err := query(key, true)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		vs, es := t.walk(key, api.toVertices(res.SubGraph.Vertices, view), api.toEdges(res.SubGraph.Edges), reverse)
+		for _, v := range vs {
+			if _, ok := seenVertices[v.Key]; !ok {
+				seenVertices[v.Key] = struct{}{}
+				vertices = append(vertices, v)
+			}
+		}
+		for _, e := range es {
+			if _, ok := seenEdges[e.Key]; !ok {
+				seenEdges[e.Key] = struct{}{}
+				edges = append(edges, e)
+			}
+		}
+	}
+	return vertices, edges, nil
+}
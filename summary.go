@@ -0,0 +1,243 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	defaultSummaryLimit = 20
+	maxSummaryLimit     = 500
+	defaultStaleAfter   = 15 * 60
+	defaultTopImpacted  = 5
+	maxTopImpacted      = 50
+)
+
+// impactCache keeps the dependent counts of the top impacted vertices until
+// the graph changes.
+type impactCache struct {
+	mu      sync.Mutex
+	version uint64
+	counts  map[string]int
+}
+
+// dependentCounts returns how many vertices depend on each vertex, directly
+// or not.
+func (api *API) dependentCounts() map[string]int {
+	api.mu.RLock()
+	defer api.mu.RUnlock()
+
+	c := api.impact
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.counts != nil && c.version == api.version {
+		return c.counts
+	}
+	edges := make([]Edge, 0, len(api.catalog.edges))
+	for _, e := range api.catalog.edges {
+		edges = append(edges, toEdge(e))
+	}
+	prev := adjacency(edges, true)
+	counts := make(map[string]int, len(api.catalog.vertices))
+	for k := range api.catalog.vertices {
+		// hops counts the vertex itself
+		counts[k] = len(hops(k, prev)) - 1
+	}
+	c.version, c.counts = api.version, counts
+	return counts
+}
+
+// graphChecks returns when graphlib last checked each vertex, which is all
+// there is for the vertices no one reported.
+func (api *API) graphChecks() map[string]time.Time {
+	api.mu.RLock()
+	defer api.mu.RUnlock()
+
+	checks := make(map[string]time.Time, len(api.catalog.vertices))
+	for k := range api.catalog.vertices {
+		if v, err := api.graph.GetVertex(k); err == nil {
+			checks[k] = time.Unix(0, api.lastCheckLocked(v))
+		}
+	}
+	return checks
+}
+
+// count adds a vertex with status to c.
+func (c *StatusCounts) count(status HealthStatus) {
+	switch status {
+	case Healthy:
+		c.Healthy++
+	case Degraded:
+		c.Degraded++
+	case Down:
+		c.Down++
+	case Unknown:
+		c.Unknown++
+	}
+}
+
+// ratio is the healthy fraction of total, 1 when there is nothing to count.
+func ratio(total, unhealthy int) float64 {
+	if total == 0 {
+		return 1
+	}
+	return float64(total-unhealthy) / float64(total)
+}
+
+func (api *API) Summary(ctx context.Context, request SummaryRequestObject) (SummaryResponseObject, error) {
+	params := request.Params
+
+	limit := defaultSummaryLimit
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+	if limit < 1 || limit > maxSummaryLimit {
+		ir := InvalidRequestJSONResponse{Code: 422, Error: fmt.Sprintf("limit must be between 1 and %d", maxSummaryLimit)}
+		return Summary422JSONResponse{InvalidRequestJSONResponse: ir}, nil
+	}
+	staleAfter := defaultStaleAfter
+	if params.StaleAfter != nil {
+		staleAfter = *params.StaleAfter
+	}
+	if staleAfter < 1 {
+		ir := InvalidRequestJSONResponse{Code: 422, Error: "stale_after must be at least 1 second"}
+		return Summary422JSONResponse{InvalidRequestJSONResponse: ir}, nil
+	}
+	top := defaultTopImpacted
+	if params.Top != nil {
+		top = *params.Top
+	}
+	if top < 1 || top > maxTopImpacted {
+		ir := InvalidRequestJSONResponse{Code: 422, Error: fmt.Sprintf("top must be between 1 and %d", maxTopImpacted)}
+		return Summary422JSONResponse{InvalidRequestJSONResponse: ir}, nil
+	}
+	if o := params.Origin; o != nil && *o != Direct && *o != Inherited {
+		ir := InvalidRequestJSONResponse{Code: 422, Error: fmt.Sprintf("unknown health origin %q", *o)}
+		return Summary422JSONResponse{InvalidRequestJSONResponse: ir}, nil
+	}
+
+	s := api.structure()
+	summary := Summary{
+		TotalEdges:        len(s.edges),
+		TotalVertices:     len(s.keys),
+		UnhealthyVertices: []Vertex{},
+		Classes:           []ClassSummary{},
+		StaleAfter:        staleAfter,
+		StaleVertices:     []Vertex{},
+		TopImpacted:       []ImpactedVertex{},
+	}
+
+	cutoff := time.Now().Add(-time.Duration(staleAfter) * time.Second)
+	graphChecks := api.graphChecks()
+	checked := map[string]time.Time{}
+	byClass := map[string]*ClassSummary{}
+	unhealthy := []Vertex{}
+	stale := []Vertex{}
+	for _, k := range s.keys {
+		v := s.vertices[k]
+
+		c, ok := byClass[v.Class]
+		if !ok {
+			c = &ClassSummary{Class: v.Class}
+			byClass[v.Class] = c
+		}
+		c.Total++
+		c.Statuses.count(v.Status)
+		summary.Statuses.count(v.Status)
+		if v.Severity != nil {
+			switch *v.Severity {
+			case Critical:
+				summary.Severities.Critical++
+			case Major:
+				summary.Severities.Major++
+			case Minor:
+				summary.Severities.Minor++
+			case Warning:
+				summary.Severities.Warning++
+			}
+		}
+		if !v.Healthy {
+			c.Unhealthy++
+			if v.HealthOrigin == Inherited {
+				summary.InheritedUnhealthy++
+			} else {
+				summary.DirectUnhealthy++
+			}
+			if params.Origin == nil || v.HealthOrigin == *params.Origin {
+				unhealthy = append(unhealthy, v)
+			}
+		}
+
+		// a vertex never reported was last checked when graphlib says
+		t := graphChecks[k]
+		if v.LastCheck != "" {
+			if reported, err := time.Parse(time.RFC3339, v.LastCheck); err == nil {
+				t = reported
+			}
+		}
+		if t.Before(cutoff) {
+			checked[k] = t
+			stale = append(stale, v)
+		}
+	}
+
+	summary.UnhealthyTotal = len(unhealthy)
+	summary.HealthyRatio = ratio(len(s.keys), summary.DirectUnhealthy+summary.InheritedUnhealthy)
+	if len(unhealthy) > limit {
+		last := unhealthy[limit-1]
+		q := url.Values{}
+		q.Set("healthy", "false")
+		if params.Origin != nil {
+			q.Set("origin", string(*params.Origin))
+		}
+		q.Set("limit", strconv.Itoa(limit))
+		q.Set("cursor", encodeCursor(listCursor{Sort: "key", Value: last.Key, Key: last.Key}))
+		next := "/vertices?" + q.Encode()
+		summary.UnhealthyNext = &next
+		unhealthy = unhealthy[:limit]
+	}
+	summary.UnhealthyVertices = unhealthy
+
+	for _, c := range byClass {
+		c.HealthyRatio = ratio(c.Total, c.Unhealthy)
+		summary.Classes = append(summary.Classes, *c)
+	}
+	sort.Slice(summary.Classes, func(i, j int) bool {
+		a, b := summary.Classes[i], summary.Classes[j]
+		if a.Unhealthy != b.Unhealthy {
+			return a.Unhealthy > b.Unhealthy
+		}
+		if a.Total != b.Total {
+			return a.Total > b.Total
+		}
+		return a.Class < b.Class
+	})
+
+	sort.SliceStable(stale, func(i, j int) bool { return checked[stale[i].Key].Before(checked[stale[j].Key]) })
+	summary.StaleTotal = len(stale)
+	if len(stale) > limit {
+		stale = stale[:limit]
+	}
+	summary.StaleVertices = stale
+
+	counts := api.dependentCounts()
+	for _, k := range s.keys {
+		if n := counts[k]; n > 0 {
+			summary.TopImpacted = append(summary.TopImpacted, ImpactedVertex{Vertex: s.vertices[k], Dependents: n})
+		}
+	}
+	sort.SliceStable(summary.TopImpacted, func(i, j int) bool {
+		return summary.TopImpacted[i].Dependents > summary.TopImpacted[j].Dependents
+	})
+	if len(summary.TopImpacted) > top {
+		summary.TopImpacted = summary.TopImpacted[:top]
+	}
+
+	return Summary200JSONResponse(summary), nil
+}
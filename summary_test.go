@@ -0,0 +1,174 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+func summarize(t *testing.T, api *API, params SummaryParams) Summary {
+	t.Helper()
+	res, err := api.Summary(context.Background(), SummaryRequestObject{Params: params})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, ok := res.(Summary200JSONResponse)
+	if !ok {
+		t.Fatalf("got %+v", res)
+	}
+	return Summary(s)
+}
+
+func vertexKeys(vs []Vertex) []string {
+	keys := []string{}
+	for _, v := range vs {
+		keys = append(keys, v.Key)
+	}
+	return keys
+}
+
+func TestSummaryUnhealthy(t *testing.T) {
+	api := testAPI(t, "web>db", "app>web", "app>cache")
+	if _, err := api.MarkVertexUnhealthy(context.Background(), MarkVertexUnhealthyRequestObject{Key: "db"}); err != nil {
+		t.Fatal(err)
+	}
+	// what the loop does on its next tick
+	for _, k := range []string{"web", "app"} {
+		if err := api.graph.SetVertexHealth(k, false); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	s := summarize(t, api, SummaryParams{})
+	if s.DirectUnhealthy != 1 || s.InheritedUnhealthy != 2 || s.UnhealthyTotal != 3 || s.HealthyRatio != 0.25 {
+		t.Errorf("got %+v", s)
+	}
+	if got := strings.Join(vertexKeys(s.UnhealthyVertices), ","); got != "app,db,web" || s.UnhealthyNext != nil {
+		t.Errorf("got %s", got)
+	}
+
+	one := 1
+	s = summarize(t, api, SummaryParams{Limit: &one})
+	if got := strings.Join(vertexKeys(s.UnhealthyVertices), ","); got != "app" || s.UnhealthyTotal != 3 {
+		t.Errorf("got %s of %d", got, s.UnhealthyTotal)
+	}
+	if s.UnhealthyNext == nil || !strings.HasPrefix(*s.UnhealthyNext, "/vertices?cursor=") || !strings.Contains(*s.UnhealthyNext, "healthy=false&limit=1") {
+		t.Errorf("got next %v", s.UnhealthyNext)
+	}
+
+	for origin, want := range map[HealthOrigin]string{Direct: "db", Inherited: "app,web"} {
+		s = summarize(t, api, SummaryParams{Origin: &origin})
+		if got := strings.Join(vertexKeys(s.UnhealthyVertices), ","); got != want || s.UnhealthyTotal != strings.Count(want, ",")+1 {
+			t.Errorf("%s: got %s", origin, got)
+		}
+		// the counts cover every origin
+		if s.DirectUnhealthy != 1 || s.InheritedUnhealthy != 2 {
+			t.Errorf("%s: got %d direct and %d inherited", origin, s.DirectUnhealthy, s.InheritedUnhealthy)
+		}
+	}
+}
+
+func TestSummaryStale(t *testing.T) {
+	api := testAPI(t, "web>db", "app>web", "app>cache")
+	if _, err := api.MarkVertexUnhealthy(context.Background(), MarkVertexUnhealthyRequestObject{Key: "db"}); err != nil {
+		t.Fatal(err)
+	}
+
+	// web was reported an hour ago, graphlib last checked the cache two
+	// hours ago and app when it was added
+	api.history.checked["web"] = time.Now().Add(-time.Hour)
+	if err := api.rebuild(api.catalog.clone()); err != nil {
+		t.Fatal(err)
+	}
+	c := api.carried["cache"]
+	c.at = time.Now().Add(-2 * time.Hour).UnixNano()
+	api.carried["cache"] = c
+
+	staleAfter := 600
+	s := summarize(t, api, SummaryParams{StaleAfter: &staleAfter})
+	if got := strings.Join(vertexKeys(s.StaleVertices), ","); got != "cache,web" || s.StaleTotal != 2 || s.StaleAfter != 600 {
+		t.Errorf("got %s of %d", got, s.StaleTotal)
+	}
+
+	one := 1
+	s = summarize(t, api, SummaryParams{StaleAfter: &staleAfter, Limit: &one})
+	if got := strings.Join(vertexKeys(s.StaleVertices), ","); got != "cache" || s.StaleTotal != 2 {
+		t.Errorf("got %s of %d", got, s.StaleTotal)
+	}
+
+	// the default is 15 minutes
+	s = summarize(t, api, SummaryParams{})
+	if s.StaleAfter != defaultStaleAfter || s.StaleTotal != 2 {
+		t.Errorf("got %d stale after %d seconds", s.StaleTotal, s.StaleAfter)
+	}
+	staleAfter = 3 * 60 * 60
+	if s = summarize(t, api, SummaryParams{StaleAfter: &staleAfter}); s.StaleTotal != 0 {
+		t.Errorf("got %v stale after 3 hours", vertexKeys(s.StaleVertices))
+	}
+}
+
+func TestSummaryTopImpacted(t *testing.T) {
+	api := testAPI(t, "web>db", "app>web", "app>cache")
+
+	s := summarize(t, api, SummaryParams{})
+	got := []string{}
+	for _, iv := range s.TopImpacted {
+		got = append(got, fmt.Sprintf("%s:%d", iv.Vertex.Key, iv.Dependents))
+	}
+	if strings.Join(got, ",") != "db:2,cache:1,web:1" {
+		t.Errorf("got %v", got)
+	}
+
+	one := 1
+	if s = summarize(t, api, SummaryParams{Top: &one}); len(s.TopImpacted) != 1 || s.TopImpacted[0].Vertex.Key != "db" {
+		t.Errorf("got %+v", s.TopImpacted)
+	}
+}
+
+func TestDependentCounts(t *testing.T) {
+	api := testAPI(t, "web>db", "app>web")
+
+	if got := api.dependentCounts(); got["db"] != 2 || got["web"] != 1 || got["app"] != 0 {
+		t.Errorf("got %v", got)
+	}
+	api.impact.counts["db"] = 99
+	if got := api.dependentCounts(); got["db"] != 99 {
+		t.Error("the counts were computed again without a change to the graph")
+	}
+
+	api.AddVertex("batch", "batch", "server", true)
+	if err := api.AddEdge("batch", "db", "runs-on", "usa"); err != nil {
+		t.Fatal(err)
+	}
+	if got := api.dependentCounts(); got["db"] != 3 {
+		t.Errorf("got %v after the new edge", got)
+	}
+	if _, err := api.DeleteVertex(context.Background(), DeleteVertexRequestObject{Key: "app"}); err != nil {
+		t.Fatal(err)
+	}
+	if got := api.dependentCounts(); got["db"] != 2 || got["web"] != 0 {
+		t.Errorf("got %v after the delete", got)
+	}
+}
+
+func TestSummaryParams(t *testing.T) {
+	api := testAPI(t, "web>db")
+	zero, big := 0, 1000
+	origin := HealthOrigin("elsewhere")
+
+	for name, params := range map[string]SummaryParams{
+		"limit":       {Limit: &zero},
+		"big limit":   {Limit: &big},
+		"stale_after": {StaleAfter: &zero},
+		"top":         {Top: &zero},
+		"big top":     {Top: &big},
+		"origin":      {Origin: &origin},
+	} {
+		res, _ := api.Summary(context.Background(), SummaryRequestObject{Params: params})
+		if _, ok := res.(Summary422JSONResponse); !ok {
+			t.Errorf("%s: got %T", name, res)
+		}
+	}
+}
@@ -0,0 +1,117 @@
+package api
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/opsminded/graphlib/v2"
+)
+
+func (api *API) CreateVertex(ctx context.Context, request CreateVertexRequestObject) (CreateVertexResponseObject, error) {
+	if request.Body == nil {
+		ir := InvalidRequestJSONResponse{Code: 422, Error: "request body is required"}
+		return CreateVertex422JSONResponse{InvalidRequestJSONResponse: ir}, nil
+	}
+
+	body := *request.Body
+	if body.Key == "" || body.Label == "" || body.Class == "" {
+		ir := InvalidRequestJSONResponse{Code: 422, Error: "key, label and class are required"}
+		return CreateVertex422JSONResponse{InvalidRequestJSONResponse: ir}, nil
+	}
+
+	healthy := true
+	if body.Healthy != nil {
+		healthy = *body.Healthy
+	}
+
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	if _, ok := api.catalog.vertices[body.Key]; ok {
+		ir := InvalidRequestJSONResponse{Code: 422, Error: fmt.Sprintf("vertex %q already exists", body.Key)}
+		return CreateVertex422JSONResponse{InvalidRequestJSONResponse: ir}, nil
+	}
+
+	api.addVertex(body.Key, body.Label, body.Class, healthy)
+
+	p, err := api.service.GetVertex(body.Key)
+	if err != nil {
+		ise := InternalServerErrorJSONResponse{Code: 500, Error: err.Error()}
+		return CreateVertex500JSONResponse{InternalServerErrorJSONResponse: ise}, nil
+	}
+
+	view := api.healthViewLocked()
+	api.events.publish(vertexEvent("vertex.created", p.Key, p.Class, p.Healthy))
+	api.announceHealthLocked()
+	return CreateVertex201JSONResponse(api.toVertex(p, view)), nil
+}
+
+func (api *API) UpdateVertex(ctx context.Context, request UpdateVertexRequestObject) (UpdateVertexResponseObject, error) {
+	if request.Body == nil {
+		ir := InvalidRequestJSONResponse{Code: 422, Error: "request body is required"}
+		return UpdateVertex422JSONResponse{InvalidRequestJSONResponse: ir}, nil
+	}
+
+	body := *request.Body
+	if body.Label == "" || body.Class == "" {
+		ir := InvalidRequestJSONResponse{Code: 422, Error: "label and class are required"}
+		return UpdateVertex422JSONResponse{InvalidRequestJSONResponse: ir}, nil
+	}
+
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	rec, ok := api.catalog.vertices[request.Key]
+	if !ok {
+		nf := NotFoundJSONResponse{Code: 404, Error: graphlib.VertexNotFoundErr{Key: request.Key}.Error()}
+		return UpdateVertex404JSONResponse{NotFoundJSONResponse: nf}, nil
+	}
+
+	rec.Label = body.Label
+	rec.Class = body.Class
+	next := api.catalog.clone()
+	next.vertices[request.Key] = rec
+
+	if err := api.rebuild(next); err != nil {
+		ise := InternalServerErrorJSONResponse{Code: 500, Error: err.Error()}
+		return UpdateVertex500JSONResponse{InternalServerErrorJSONResponse: ise}, nil
+	}
+
+	p, err := api.service.GetVertex(request.Key)
+	if err != nil {
+		ise := InternalServerErrorJSONResponse{Code: 500, Error: err.Error()}
+		return UpdateVertex500JSONResponse{InternalServerErrorJSONResponse: ise}, nil
+	}
+
+	view := api.healthViewLocked()
+	api.events.publish(vertexEvent("vertex.updated", p.Key, p.Class, p.Healthy))
+	api.announceHealthLocked()
+	return UpdateVertex200JSONResponse(api.toVertex(p, view)), nil
+}
+
+func (api *API) DeleteVertex(ctx context.Context, request DeleteVertexRequestObject) (DeleteVertexResponseObject, error) {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	rec, ok := api.catalog.vertices[request.Key]
+	if !ok {
+		nf := NotFoundJSONResponse{Code: 404, Error: graphlib.VertexNotFoundErr{Key: request.Key}.Error()}
+		return DeleteVertex404JSONResponse{NotFoundJSONResponse: nf}, nil
+	}
+	old, _ := api.graph.GetVertex(request.Key)
+
+	next := api.catalog.clone()
+	next.removeVertex(request.Key)
+
+	if err := api.rebuild(next); err != nil {
+		ise := InternalServerErrorJSONResponse{Code: 500, Error: err.Error()}
+		return DeleteVertex500JSONResponse{InternalServerErrorJSONResponse: ise}, nil
+	}
+	api.attributes.remove(request.Key, nil)
+	api.history.remove(request.Key)
+	api.propagation.remove(request.Key)
+
+	api.events.publish(vertexEvent("vertex.deleted", rec.Key, rec.Class, old.Healthy))
+	api.announceHealthLocked()
+	return DeleteVertex200Response{}, nil
+}
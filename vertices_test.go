@@ -0,0 +1,186 @@
+package api
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+)
+
+// testAPI returns an API with a quiet logger serving the "src>tgt" pairs,
+// every vertex of class server and every edge of class runs-on.
+func testAPI(t *testing.T, pairs ...string) *API {
+	t.Helper()
+	api := New(slog.New(slog.NewTextHandler(io.Discard, nil)))
+	for _, p := range pairs {
+		src, tgt, _ := strings.Cut(p, ">")
+		api.AddVertex(src, src, "server", true)
+		api.AddVertex(tgt, tgt, "server", true)
+		if err := api.AddEdge(src, tgt, "runs-on", "usa"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return api
+}
+
+func TestCreateVertex(t *testing.T) {
+	api := testAPI(t, "web>db")
+	down := false
+
+	cases := []struct {
+		name    string
+		body    *NewVertex
+		healthy bool
+		err     string
+	}{
+		{name: "no body", err: "request body is required"},
+		{name: "no class", body: &NewVertex{Key: "cache", Label: "Cache"}, err: "key, label and class are required"},
+		{name: "existing key", body: &NewVertex{Key: "db", Label: "DB", Class: "database"}, err: `vertex "db" already exists`},
+		{name: "healthy by default", body: &NewVertex{Key: "cache", Label: "Cache", Class: "cache"}, healthy: true},
+		{name: "unhealthy", body: &NewVertex{Key: "queue", Label: "Queue", Class: "queue", Healthy: &down}},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			res, err := api.CreateVertex(context.Background(), CreateVertexRequestObject{Body: c.body})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if c.err != "" {
+				r, ok := res.(CreateVertex422JSONResponse)
+				if !ok || r.Error != c.err {
+					t.Fatalf("got %+v, want 422 %q", res, c.err)
+				}
+				return
+			}
+			v, ok := res.(CreateVertex201JSONResponse)
+			if !ok {
+				t.Fatalf("got %T", res)
+			}
+			if v.Key != c.body.Key || v.Label != c.body.Label || v.Class != c.body.Class || v.Healthy != c.healthy {
+				t.Errorf("got %+v", v)
+			}
+			if _, ok := api.catalog.vertices[c.body.Key]; !ok {
+				t.Error("vertex is not in the catalog")
+			}
+		})
+	}
+}
+
+func TestUpdateVertex(t *testing.T) {
+	api := testAPI(t, "web>db")
+	ctx := context.Background()
+	if err := api.graph.SetVertexHealth("db", false); err != nil {
+		t.Fatal(err)
+	}
+	before, _ := api.graph.GetVertex("db")
+
+	res, err := api.UpdateVertex(ctx, UpdateVertexRequestObject{Key: "db", Body: &VertexUpdate{Label: "DB prod", Class: "database"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	v, ok := res.(UpdateVertex200JSONResponse)
+	if !ok {
+		t.Fatalf("got %T", res)
+	}
+	if v.Label != "DB prod" || v.Class != "database" || v.Healthy {
+		t.Errorf("got %+v", v)
+	}
+
+	after, _ := api.graph.GetVertex("db")
+	if after.Class != "database" || after.Healthy {
+		t.Errorf("graph has %+v", after)
+	}
+	if got := api.lastCheckLocked(after); got != before.LastCheck {
+		t.Errorf("last check went from %d to %d", before.LastCheck, got)
+	}
+	if _, ok := api.catalog.edges[edgeKey("web", "db")]; !ok {
+		t.Error("the edge was lost")
+	}
+
+	res, _ = api.UpdateVertex(ctx, UpdateVertexRequestObject{Key: "gone", Body: &VertexUpdate{Label: "Gone", Class: "server"}})
+	if _, ok := res.(UpdateVertex404JSONResponse); !ok {
+		t.Errorf("got %T for an unknown vertex", res)
+	}
+	res, _ = api.UpdateVertex(ctx, UpdateVertexRequestObject{Key: "db", Body: &VertexUpdate{Label: "DB"}})
+	if _, ok := res.(UpdateVertex422JSONResponse); !ok {
+		t.Errorf("got %T without a class", res)
+	}
+}
+
+func TestDeleteVertex(t *testing.T) {
+	api := testAPI(t, "web>db", "app>web")
+	ctx := context.Background()
+	api.attributes.replace("web", VertexAttrubutes{{Description: "owner", Type: "string"}})
+
+	res, err := api.DeleteVertex(ctx, DeleteVertexRequestObject{Key: "web"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := res.(DeleteVertex200Response); !ok {
+		t.Fatalf("got %T", res)
+	}
+
+	if _, err := api.graph.GetVertex("web"); err == nil {
+		t.Error("the vertex is still in the graph")
+	}
+	if len(api.catalog.edges) != 0 {
+		t.Errorf("edges left: %v", api.catalog.edgeKeys())
+	}
+	if len(api.attributes.get("web")) != 0 {
+		t.Error("the attributes were kept")
+	}
+	for _, k := range []string{"app", "db"} {
+		if _, err := api.graph.GetVertex(k); err != nil {
+			t.Errorf("%s: %v", k, err)
+		}
+	}
+
+	res, _ = api.DeleteVertex(ctx, DeleteVertexRequestObject{Key: "web"})
+	if _, ok := res.(DeleteVertex404JSONResponse); !ok {
+		t.Errorf("got %T deleting twice", res)
+	}
+}
+
+func TestExpireChecks(t *testing.T) {
+	api := testAPI(t, "web>db", "app>web")
+	interval := time.Minute
+
+	next := api.catalog.clone()
+	delete(next.edges, edgeKey("app", "web"))
+	if err := api.rebuild(next); err != nil {
+		t.Fatal(err)
+	}
+
+	// db was checked long ago, web again after the rebuild
+	old := time.Now().Add(-2 * interval).UnixNano()
+	c := api.carried["db"]
+	c.at = old
+	api.carried["db"] = c
+	c = api.carried["web"]
+	c.at = old
+	api.carried["web"] = c
+	time.Sleep(time.Millisecond)
+	if err := api.graph.SetVertexHealth("web", true); err != nil {
+		t.Fatal(err)
+	}
+
+	api.expireChecks(interval, time.Now().UnixNano())
+
+	for k, want := range map[string]bool{"db": false, "web": true, "app": true} {
+		v, _ := api.graph.GetVertex(k)
+		if v.Healthy != want {
+			t.Errorf("%s: got healthy %v, want %v", k, v.Healthy, want)
+		}
+	}
+	if _, ok := api.carried["db"]; ok {
+		t.Error("the expired check is still carried")
+	}
+	if _, ok := api.carried["web"]; ok {
+		t.Error("a check graphlib made again is still carried")
+	}
+	if _, ok := api.carried["app"]; !ok {
+		t.Error("a recent check was dropped")
+	}
+}
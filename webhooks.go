@@ -0,0 +1,457 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	// webhookQueue is how many events may wait for the dispatcher before new
+	// ones are dropped.
+	webhookQueue = 1024
+	// webhookLog is how many deliveries are kept per subscription.
+	webhookLog = 100
+	// webhookBacklog is how many deliveries may wait for a subscription
+	// before new ones fail.
+	webhookBacklog  = 100
+	webhookAttempts = 5
+	webhookBackoff  = time.Second
+	webhookTimeout  = 10 * time.Second
+)
+
+var defaultWebhookEvents = []string{"vertex.unhealthy", "vertex.healthy"}
+
+type webhookSubscription struct {
+	Webhook
+	secret     string
+	filter     eventFilter
+	events     map[string]struct{}
+	deliveries []WebhookDelivery
+	worker     *webhookWorker
+}
+
+// webhookWorker delivers the events of one subscription in order, so a slow
+// endpoint only holds back its own deliveries.
+type webhookWorker struct {
+	queue chan queuedDelivery
+	done  chan struct{}
+}
+
+type queuedDelivery struct {
+	id    string
+	event Event
+}
+
+type webhookDispatcher struct {
+	api *API
+
+	mu           sync.RWMutex
+	lastID       int
+	lastDelivery int
+	subs         map[string]*webhookSubscription
+
+	queue    chan Event
+	start    sync.Once
+	client   *http.Client
+	attempts int
+	backoff  time.Duration
+	backlog  int
+}
+
+func newWebhookDispatcher(api *API) *webhookDispatcher {
+	return &webhookDispatcher{
+		api:      api,
+		subs:     make(map[string]*webhookSubscription),
+		queue:    make(chan Event, webhookQueue),
+		client:   &http.Client{Timeout: webhookTimeout},
+		attempts: webhookAttempts,
+		backoff:  webhookBackoff,
+		backlog:  webhookBacklog,
+	}
+}
+
+// enqueue is the event broker forward function. Events are only queued once
+// there is a subscription, and dropped when the queue is full.
+func (d *webhookDispatcher) enqueue(e Event) {
+	d.mu.RLock()
+	empty := len(d.subs) == 0
+	d.mu.RUnlock()
+	if empty {
+		return
+	}
+
+	select {
+	case d.queue <- e:
+	default:
+		d.api.logger.Warn("webhook queue is full, dropping event", "event", e.Id, "type", e.Type)
+	}
+}
+
+// run matches queued events against the subscriptions and hands the
+// deliveries to their workers. A delivery fails at once when its worker is
+// too far behind.
+func (d *webhookDispatcher) run() {
+	for e := range d.queue {
+		d.mu.RLock()
+		subs := make([]*webhookSubscription, 0, len(d.subs))
+		for _, s := range d.subs {
+			subs = append(subs, s)
+		}
+		d.mu.RUnlock()
+
+		for _, s := range subs {
+			if _, ok := s.events[e.Type]; !ok {
+				continue
+			}
+			if !d.api.matchesEvent(s.filter, e) {
+				continue
+			}
+			id, ok := d.record(s.Id, e)
+			if !ok {
+				continue
+			}
+			select {
+			case s.worker.queue <- queuedDelivery{id: id, event: e}:
+			default:
+				d.update(s.Id, id, func(dl *WebhookDelivery) {
+					msg := "delivery queue is full"
+					dl.Status = "failed"
+					dl.Error = &msg
+					dl.UpdatedAt = time.Now().UTC()
+				})
+			}
+		}
+	}
+}
+
+func (d *webhookDispatcher) newWorker() *webhookWorker {
+	return &webhookWorker{
+		queue: make(chan queuedDelivery, d.backlog),
+		done:  make(chan struct{}),
+	}
+}
+
+// work delivers the queued events of a subscription until it is removed.
+func (d *webhookDispatcher) work(subID string, w *webhookWorker) {
+	for {
+		select {
+		case <-w.done:
+			return
+		case q := <-w.queue:
+			d.deliver(subID, q.id, q.event, w.done)
+		}
+	}
+}
+
+// record adds a pending delivery to the log of the subscription.
+func (d *webhookDispatcher) record(subID string, e Event) (string, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	s, ok := d.subs[subID]
+	if !ok {
+		return "", false
+	}
+	d.lastDelivery++
+	now := time.Now().UTC()
+	dl := WebhookDelivery{
+		Id:        strconv.Itoa(d.lastDelivery),
+		EventId:   e.Id,
+		EventType: e.Type,
+		Status:    "pending",
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	s.deliveries = append(s.deliveries, dl)
+	if len(s.deliveries) > webhookLog {
+		s.deliveries = s.deliveries[len(s.deliveries)-webhookLog:]
+	}
+	return dl.Id, true
+}
+
+// update applies fn to a logged delivery. It reports false when the
+// subscription was removed, which cancels the delivery.
+func (d *webhookDispatcher) update(subID, deliveryID string, fn func(*WebhookDelivery)) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	s, ok := d.subs[subID]
+	if !ok {
+		return false
+	}
+	for i := range s.deliveries {
+		if s.deliveries[i].Id == deliveryID {
+			fn(&s.deliveries[i])
+			break
+		}
+	}
+	return true
+}
+
+// deliver posts the event, retrying with exponential backoff until the
+// endpoint answers 2xx, the attempts run out or done is closed.
+func (d *webhookDispatcher) deliver(subID, deliveryID string, e Event, done <-chan struct{}) {
+	wait := d.backoff
+	for attempt := 1; attempt <= d.attempts; attempt++ {
+		// read on every attempt so updates to the subscription apply
+		d.mu.RLock()
+		s, ok := d.subs[subID]
+		d.mu.RUnlock()
+		if !ok {
+			return
+		}
+
+		code, err := d.post(s.Webhook, s.secret, deliveryID, e)
+
+		status := "pending"
+		switch {
+		case err == nil:
+			status = "delivered"
+		case attempt == d.attempts:
+			status = "failed"
+		}
+		logged := d.update(subID, deliveryID, func(dl *WebhookDelivery) {
+			dl.Attempts = attempt
+			dl.Status = status
+			dl.UpdatedAt = time.Now().UTC()
+			dl.ResponseCode = nil
+			if code != 0 {
+				dl.ResponseCode = &code
+			}
+			dl.Error = nil
+			if err != nil {
+				msg := err.Error()
+				dl.Error = &msg
+			}
+		})
+		if !logged || status != "pending" {
+			return
+		}
+
+		select {
+		case <-time.After(wait):
+		case <-done:
+			return
+		}
+		wait *= 2
+	}
+}
+
+func (d *webhookDispatcher) post(sub Webhook, secret, deliveryID string, e Event) (int, error) {
+	body, err := json.Marshal(WebhookPayload{Subscription: sub.Id, Delivery: deliveryID, Event: e})
+	if err != nil {
+		return 0, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, sub.Url, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Opsmind-Event", e.Type)
+	req.Header.Set("X-Opsmind-Delivery", deliveryID)
+	req.Header.Set("X-Opsmind-Signature", "sha256="+signPayload(secret, body))
+
+	res, err := d.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return res.StatusCode, fmt.Errorf("endpoint answered %s", res.Status)
+	}
+	return res.StatusCode, nil
+}
+
+// signPayload returns the hex HMAC-SHA256 of the body, as sent in the
+// X-Opsmind-Signature header.
+func signPayload(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// newSubscription validates the body and builds a subscription with the
+// given id.
+func (api *API) newSubscription(id string, created time.Time, body *NewWebhook) (*webhookSubscription, error) {
+	if body == nil {
+		return nil, errors.New("request body is required")
+	}
+	u, err := url.Parse(body.Url)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("url %q must be an absolute http or https address", body.Url)
+	}
+	if body.Secret == "" {
+		return nil, errors.New("secret is required")
+	}
+
+	s := &webhookSubscription{
+		Webhook: Webhook{
+			Id:        id,
+			Url:       body.Url,
+			Events:    defaultWebhookEvents,
+			Key:       []string{},
+			Class:     []string{},
+			CreatedAt: created,
+		},
+		secret: body.Secret,
+		filter: eventFilter{
+			keys:    map[string]struct{}{},
+			classes: map[string]struct{}{},
+		},
+		events:     map[string]struct{}{},
+		deliveries: []WebhookDelivery{},
+	}
+	if body.Events != nil && len(*body.Events) > 0 {
+		s.Events = *body.Events
+	}
+	for _, e := range s.Events {
+		s.events[e] = struct{}{}
+	}
+	if body.Key != nil {
+		s.Key = *body.Key
+		for _, k := range s.Key {
+			s.filter.keys[k] = struct{}{}
+		}
+	}
+	if body.Class != nil {
+		s.Class = *body.Class
+		for _, c := range s.Class {
+			s.filter.classes[c] = struct{}{}
+		}
+	}
+	if body.DependentsOf != nil && *body.DependentsOf != "" {
+		if _, err := api.svc().GetVertex(*body.DependentsOf); err != nil {
+			return nil, err
+		}
+		s.DependentsOf = body.DependentsOf
+		s.filter.dependentsOf = *body.DependentsOf
+	}
+	return s, nil
+}
+
+func (api *API) ListWebhooks(ctx context.Context, request ListWebhooksRequestObject) (ListWebhooksResponseObject, error) {
+	d := api.webhooks
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	hooks := Webhooks{}
+	for _, s := range d.subs {
+		hooks = append(hooks, s.Webhook)
+	}
+	sort.Slice(hooks, func(i, j int) bool { return hooks[i].CreatedAt.Before(hooks[j].CreatedAt) })
+	return ListWebhooks200JSONResponse(hooks), nil
+}
+
+func (api *API) CreateWebhook(ctx context.Context, request CreateWebhookRequestObject) (CreateWebhookResponseObject, error) {
+	d := api.webhooks
+
+	s, err := api.newSubscription("", time.Now().UTC(), request.Body)
+	if err != nil {
+		ir := InvalidRequestJSONResponse{Code: 422, Error: err.Error()}
+		return CreateWebhook422JSONResponse{InvalidRequestJSONResponse: ir}, nil
+	}
+
+	d.mu.Lock()
+	d.lastID++
+	s.Id = strconv.Itoa(d.lastID)
+	s.worker = d.newWorker()
+	d.subs[s.Id] = s
+	d.mu.Unlock()
+	d.start.Do(func() { go d.run() })
+	go d.work(s.Id, s.worker)
+
+	return CreateWebhook201JSONResponse(s.Webhook), nil
+}
+
+func (api *API) GetWebhook(ctx context.Context, request GetWebhookRequestObject) (GetWebhookResponseObject, error) {
+	d := api.webhooks
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	s, ok := d.subs[request.Id]
+	if !ok {
+		nf := NotFoundJSONResponse{Code: 404, Error: fmt.Sprintf("webhook %q not found", request.Id)}
+		return GetWebhook404JSONResponse{NotFoundJSONResponse: nf}, nil
+	}
+	return GetWebhook200JSONResponse(s.Webhook), nil
+}
+
+func (api *API) UpdateWebhook(ctx context.Context, request UpdateWebhookRequestObject) (UpdateWebhookResponseObject, error) {
+	d := api.webhooks
+
+	d.mu.RLock()
+	old, ok := d.subs[request.Id]
+	d.mu.RUnlock()
+	if !ok {
+		nf := NotFoundJSONResponse{Code: 404, Error: fmt.Sprintf("webhook %q not found", request.Id)}
+		return UpdateWebhook404JSONResponse{NotFoundJSONResponse: nf}, nil
+	}
+
+	s, err := api.newSubscription(request.Id, old.CreatedAt, request.Body)
+	if err != nil {
+		ir := InvalidRequestJSONResponse{Code: 422, Error: err.Error()}
+		return UpdateWebhook422JSONResponse{InvalidRequestJSONResponse: ir}, nil
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	cur, ok := d.subs[request.Id]
+	if !ok {
+		nf := NotFoundJSONResponse{Code: 404, Error: fmt.Sprintf("webhook %q not found", request.Id)}
+		return UpdateWebhook404JSONResponse{NotFoundJSONResponse: nf}, nil
+	}
+	s.deliveries = cur.deliveries
+	s.worker = cur.worker
+	d.subs[request.Id] = s
+
+	return UpdateWebhook200JSONResponse(s.Webhook), nil
+}
+
+func (api *API) DeleteWebhook(ctx context.Context, request DeleteWebhookRequestObject) (DeleteWebhookResponseObject, error) {
+	d := api.webhooks
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	s, ok := d.subs[request.Id]
+	if !ok {
+		nf := NotFoundJSONResponse{Code: 404, Error: fmt.Sprintf("webhook %q not found", request.Id)}
+		return DeleteWebhook404JSONResponse{NotFoundJSONResponse: nf}, nil
+	}
+	delete(d.subs, request.Id)
+	close(s.worker.done)
+
+	return DeleteWebhook200Response{}, nil
+}
+
+func (api *API) ListWebhookDeliveries(ctx context.Context, request ListWebhookDeliveriesRequestObject) (ListWebhookDeliveriesResponseObject, error) {
+	d := api.webhooks
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	s, ok := d.subs[request.Id]
+	if !ok {
+		nf := NotFoundJSONResponse{Code: 404, Error: fmt.Sprintf("webhook %q not found", request.Id)}
+		return ListWebhookDeliveries404JSONResponse{NotFoundJSONResponse: nf}, nil
+	}
+
+	deliveries := WebhookDeliveries{}
+	for i := len(s.deliveries) - 1; i >= 0; i-- {
+		deliveries = append(deliveries, s.deliveries[i])
+	}
+	return ListWebhookDeliveries200JSONResponse(deliveries), nil
+}
@@ -0,0 +1,192 @@
+package api
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestSignPayload(t *testing.T) {
+	// RFC 4231, test case 2
+	got := signPayload("Jefe", []byte("what do ya want for nothing?"))
+	want := "5bdcc146bf60754e6a042426089575c75a003f089d2739839dec58b964ec3843"
+	if got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
+
+type receivedHook struct {
+	signature string
+	delivery  string
+	body      []byte
+}
+
+func TestWebhookDelivery(t *testing.T) {
+	const secret = "hook-secret"
+
+	received := make(chan receivedHook, 10)
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		received <- receivedHook{
+			signature: r.Header.Get("X-Opsmind-Signature"),
+			delivery:  r.Header.Get("X-Opsmind-Delivery"),
+			body:      body,
+		}
+		// the first attempt fails so the delivery is retried
+		if calls.Add(1) == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer srv.Close()
+
+	api := New(slog.New(slog.NewTextHandler(io.Discard, nil)))
+	api.webhooks.backoff = time.Millisecond
+	api.AddVertex("web", "Web", "server", true)
+	api.AddVertex("db", "DB", "database", true)
+	if err := api.AddEdge("web", "db", "runs-on", "usa"); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	dependentsOf := "db"
+	events := []string{"vertex.unhealthy"}
+	res, err := api.CreateWebhook(ctx, CreateWebhookRequestObject{Body: &NewWebhook{
+		Url:          srv.URL,
+		Secret:       secret,
+		Events:       &events,
+		DependentsOf: &dependentsOf,
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	hook, ok := res.(CreateWebhook201JSONResponse)
+	if !ok {
+		t.Fatalf("got %T creating the webhook", res)
+	}
+
+	// events are matched in order, so the web delivery arriving first means
+	// the db event was left out by the dependents_of filter
+	for _, k := range []string{"db", "web"} {
+		if _, err := api.MarkVertexUnhealthy(ctx, MarkVertexUnhealthyRequestObject{Key: k}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var delivery string
+	for attempt := 1; attempt <= 2; attempt++ {
+		var r receivedHook
+		select {
+		case r = <-received:
+		case <-time.After(5 * time.Second):
+			t.Fatalf("attempt %d was not delivered", attempt)
+		}
+
+		mac := hmac.New(sha256.New, []byte(secret))
+		mac.Write(r.body)
+		if want := "sha256=" + hex.EncodeToString(mac.Sum(nil)); r.signature != want {
+			t.Errorf("attempt %d: got signature %q, want %q", attempt, r.signature, want)
+		}
+		if delivery == "" {
+			delivery = r.delivery
+		} else if r.delivery != delivery {
+			t.Errorf("retry has delivery %q, want %q", r.delivery, delivery)
+		}
+
+		p := WebhookPayload{}
+		if err := json.Unmarshal(r.body, &p); err != nil {
+			t.Fatal(err)
+		}
+		if p.Subscription != hook.Id || p.Event.Type != "vertex.unhealthy" || p.Event.Key == nil || *p.Event.Key != "web" {
+			t.Errorf("attempt %d: unexpected payload %s", attempt, r.body)
+		}
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		api.webhooks.mu.RLock()
+		dls := append([]WebhookDelivery{}, api.webhooks.subs[hook.Id].deliveries...)
+		api.webhooks.mu.RUnlock()
+		if len(dls) == 1 && dls[0].Status == "delivered" {
+			if dls[0].Attempts != 2 {
+				t.Errorf("got %d attempts, want 2", dls[0].Attempts)
+			}
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("got deliveries %+v", dls)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	select {
+	case r := <-received:
+		t.Errorf("unexpected delivery %s", r.body)
+	default:
+	}
+}
+
+func TestWebhookBacklog(t *testing.T) {
+	started := make(chan struct{}, 10)
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		started <- struct{}{}
+		<-release
+	}))
+	defer srv.Close()
+	defer close(release)
+
+	api := testAPI(t)
+	api.webhooks.backlog = 1
+	res, err := api.CreateWebhook(context.Background(), CreateWebhookRequestObject{Body: &NewWebhook{Url: srv.URL, Secret: "s"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	hook := res.(CreateWebhook201JSONResponse)
+
+	// the first delivery holds the worker, the second waits for it and the
+	// third finds the queue full
+	api.events.publish(healthEvent("web", "server", false))
+	select {
+	case <-started:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the first delivery was not attempted")
+	}
+	api.events.publish(healthEvent("db", "server", false))
+	api.events.publish(healthEvent("app", "server", false))
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		api.webhooks.mu.RLock()
+		dls := append([]WebhookDelivery{}, api.webhooks.subs[hook.Id].deliveries...)
+		api.webhooks.mu.RUnlock()
+		if len(dls) == 3 && dls[2].Status == "failed" {
+			if dls[0].Status != "pending" || dls[1].Status != "pending" || dls[1].Attempts != 0 {
+				t.Errorf("got deliveries %+v", dls)
+			}
+			if dls[2].EventId != 3 || dls[2].Error == nil || *dls[2].Error != "delivery queue is full" {
+				t.Errorf("got %+v for the third delivery", dls[2])
+			}
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("got deliveries %+v", dls)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	// one worker, one request at a time
+	select {
+	case <-started:
+		t.Error("a second delivery ran while the first was pending")
+	default:
+	}
+}